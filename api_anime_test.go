@@ -41,6 +41,7 @@ func TestGetAnime(t *testing.T) {
 	assert.NotZero(t, d.AiringDate.End.Day)
 	assert.NotEmpty(t, d.Premiered)
 	assert.NotEmpty(t, d.Broadcast)
+	assert.NotEmpty(t, d.BroadcastTime)
 	assert.NotEmpty(t, d.Source)
 	assert.NotEmpty(t, d.Duration)
 	assert.NotEmpty(t, d.Rating)
@@ -0,0 +1,78 @@
+package malscraper
+
+import (
+	"context"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+)
+
+// ListNegativeCache to get list of negative cache entries (failed
+// requests which are cached so they won't be requested again).
+// Filter by entity if `entity` is set. Entity should be one of
+// these.
+//
+//  EntityAnime      EntityUser
+//  EntityManga      EntityClub
+//  EntityCharacter  EntityNews
+//  EntityPeople     EntityArticle
+//                   EntityReview
+//
+// The list is kept in memory and limited to the latest 1000 entries
+// for each entity. Only entries saved by this malscraper instance
+// are listed, not the ones saved by other instances sharing the same
+// cache or before the instance is created.
+func (m *Malscraper) ListNegativeCache(entity ...string) []model.NegativeCache {
+	e := ""
+	if len(entity) > 0 {
+		e = entity[0]
+	}
+	return m.validator.ListNegativeCache(e)
+}
+
+// PurgeNegativeCache to delete negative cache entries so the
+// requests will be retried. Delete all entries or only the entity's
+// if `entity` is set. Returns the number of deleted entries. Only
+// entries listed by `ListNegativeCache()` are deleted.
+func (m *Malscraper) PurgeNegativeCache(entity ...string) (int, error) {
+	e := ""
+	if len(entity) > 0 {
+		e = entity[0]
+	}
+	return m.validator.PurgeNegativeCache(e)
+}
+
+// ReparseCache to parse the entity's saved raw pages again and replace
+// their cached data without requesting MyAnimeList. Useful after the
+// parser is fixed or its cached data version is bumped. Requires
+// `PageCacher` config, otherwise returns `ErrNoPageCache`. Each page is
+// parsed by the method matching its URL (see `Dispatch()`). Returns the
+// number of parsed pages. Only the latest 10000 saved pages of each
+// entity are listed to be parsed again. The list is saved every minute,
+// so pages saved by other malscraper instances sharing the same page
+// cache in the last minute may not be listed yet.
+//
+// Entity should be one of the entity constants (`EntityAnime`, etc).
+func (m *Malscraper) ReparseCache(ctx context.Context, entity string) (int, error) {
+	if m.reparser == nil {
+		return 0, errors.ErrNoPageCache
+	}
+
+	offline := &Malscraper{api: m.reparser}
+
+	var cnt int
+	for _, url := range m.parser.ListPages(entity) {
+		if err := ctx.Err(); err != nil {
+			return cnt, err
+		}
+
+		if _, _, err := offline.Dispatch(ctx, url); err != nil {
+			m.logger.Warn("failed parsing %s again: %s", url, err.Error())
+			continue
+		}
+
+		cnt++
+	}
+
+	return cnt, nil
+}
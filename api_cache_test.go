@@ -0,0 +1,72 @@
+package malscraper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/mal-plugin/cache/bigcache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegativeCache(t *testing.T) {
+	m, err := New(Config{})
+	assert.NoError(t, err)
+
+	assert.Empty(t, m.ListNegativeCache())
+	assert.Empty(t, m.ListNegativeCache(EntityUser))
+
+	cnt, err := m.PurgeNegativeCache(EntityUser)
+	assert.NoError(t, err)
+	assert.Zero(t, cnt)
+}
+
+func TestReparseCache(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		m, err := New(Config{})
+		assert.NoError(t, err)
+
+		cnt, err := m.ReparseCache(context.Background(), EntityNews)
+		assert.Equal(t, errors.ErrNoPageCache, err)
+		assert.Zero(t, cnt)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		pc, err := bigcache.New(time.Hour)
+		assert.NoError(t, err)
+
+		m, err := New(Config{PageCacher: pc})
+		assert.NoError(t, err)
+
+		now := time.Now()
+		newsURL := "https://myanimelist.net/news/1"
+		body := `<div id="contentWrapper"><div class="content-left"><p class="title">News Title</p></div></div>`
+		assert.NoError(t, pc.Set(internal.GetKey(internal.KeyPage, newsURL), map[string]interface{}{
+			"body":     []byte(body),
+			"cachedAt": now,
+		}))
+		assert.NoError(t, pc.Set(internal.GetKey(internal.KeyPageIndex, EntityNews), map[string]time.Time{
+			newsURL:                          now,
+			"https://myanimelist.net/news/2": now,
+		}))
+
+		cnt, err := m.ReparseCache(context.Background(), EntityNews)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, cnt)
+
+		// Cached without requesting MyAnimeList.
+		d, _, err := m.GetNews(1)
+		assert.NoError(t, err)
+		assert.Equal(t, "News Title", d.Title)
+
+		// Missing page is removed from the list.
+		assert.Equal(t, []string{newsURL}, m.parser.ListPages(EntityNews))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = m.ReparseCache(ctx, EntityNews)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
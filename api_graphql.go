@@ -0,0 +1,43 @@
+package malscraper
+
+import (
+	"net/http"
+
+	"github.com/rl404/go-malscraper/internal/graphql"
+)
+
+// GraphQL to get GraphQL HTTP handler. The schema is generated
+// from the models and each field is resolved using the same
+// methods as malscraper (with caching and validation). Accept
+// GET request with `query` param and POST request with JSON body
+// (`query`, `operationName`, and `variables`).
+//
+// Related data can be requested in one query. For example, anime's
+// characters, their voice actors, and the voice actors' details.
+//
+//  {
+//    anime(id: 1) {
+//      title
+//      characters {
+//        name
+//        voiceActors {
+//          name
+//          person { givenName familyName }
+//        }
+//      }
+//    }
+//  }
+//
+// Repeated lookups with the same ID in a query (e.g. the same voice
+// actor for different characters) will only be parsed once.
+//
+// To prevent a query from requesting too many pages, query is rejected
+// before being executed if it has more nested fields than
+// `GraphQLMaxDepth` or its cost is more than `GraphQLMaxCost` config.
+// Cost is the estimated number of methods called, where a field inside
+// a list is assumed to be called for 10 items. The query above has
+// depth 5 and cost 112 (anime 1 + characters 1 + voiceActors 10 +
+// person 100).
+func (m *Malscraper) GraphQL() (http.Handler, error) {
+	return graphql.New(m.api, m.logger, m.graphqlCfg)
+}
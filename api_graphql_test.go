@@ -0,0 +1,43 @@
+package malscraper
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQL(t *testing.T) {
+	h, err := mal.GraphQL()
+	require.NoError(t, err)
+
+	body := `{"query":"{ anime(id: 1) { id title characters { name voiceActors { name } } } }"}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp struct {
+		Data struct {
+			Anime struct {
+				ID         int    `json:"id"`
+				Title      string `json:"title"`
+				Characters []struct {
+					Name string `json:"name"`
+				} `json:"characters"`
+			} `json:"anime"`
+		} `json:"data"`
+		Errors []interface{} `json:"errors"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Empty(t, resp.Errors)
+
+	assert.Equal(t, resp.Data.Anime.ID, 1)
+	assert.Equal(t, resp.Data.Anime.Title, "Cowboy Bebop")
+	assert.NotZero(t, len(resp.Data.Anime.Characters))
+	time.Sleep(sleepDur)
+}
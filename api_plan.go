@@ -0,0 +1,94 @@
+package malscraper
+
+import (
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/model"
+)
+
+// Number of entries in a page of user anime & manga list.
+const userListPageSize = 300
+
+// Plan to get MyAnimeList requests which would be sent and cached
+// data which would be used since the malscraper is created or
+// `ResetPlan()` is called. Only available in `DryRun` mode.
+//
+// Requests for all pages of user anime & manga list (page -1) are
+// estimated because only the first page is known before requesting.
+// Their page count is estimated from cached user stats if available.
+// Methods which call several methods (`CompareUserAnime()`,
+// `GetUserAnimeBreakdown()`, and `GetSeasonSchedule()`) continue after
+// not cached data to record all known requests. Requests for the list
+// which the following requests depend on are estimated because the
+// following requests are still unknown.
+func (m *Malscraper) Plan() model.Plan {
+	if m.planner == nil {
+		return model.Plan{
+			Requests: []model.PlanRequest{},
+			Keys:     []model.PlanKey{},
+		}
+	}
+	return m.planner.Plan()
+}
+
+// ResetPlan to clear recorded requests and cached data usage.
+func (m *Malscraper) ResetPlan() {
+	if m.planner != nil {
+		m.planner.Reset()
+	}
+}
+
+func (m *Malscraper) planLen() int {
+	if m.planner == nil {
+		return 0
+	}
+	return m.planner.Len()
+}
+
+func (m *Malscraper) estimateFrom(n int) {
+	if m.planner != nil {
+		m.planner.EstimateFrom(n)
+	}
+}
+
+func (m *Malscraper) estimatePages(n, pages int) {
+	if m.planner != nil && pages > 0 {
+		m.planner.EstimatePages(n, pages)
+	}
+}
+
+// getUserListPages to get the page count of all pages of user
+// anime or manga list from cached user stats. Returns 0 if the
+// stats is not cached.
+func (m *Malscraper) getUserListPages(username string, status int, isAnime bool) int {
+	var stats *model.UserStats
+	if m.cacher.Get(internal.GetKey(internal.KeyUserStats, username), &stats) != nil || stats == nil {
+		return 0
+	}
+
+	counts := map[int]int{
+		StatusCurrent:   stats.Anime.Current,
+		StatusCompleted: stats.Anime.Completed,
+		StatusOnHold:    stats.Anime.OnHold,
+		StatusDropped:   stats.Anime.Dropped,
+		StatusPlanned:   stats.Anime.Planned,
+		StatusAll:       stats.Anime.Total,
+	}
+	if !isAnime {
+		counts = map[int]int{
+			StatusCurrent:   stats.Manga.Current,
+			StatusCompleted: stats.Manga.Completed,
+			StatusOnHold:    stats.Manga.OnHold,
+			StatusDropped:   stats.Manga.Dropped,
+			StatusPlanned:   stats.Manga.Planned,
+			StatusAll:       stats.Manga.Total,
+		}
+	}
+
+	count, ok := counts[status]
+	if !ok {
+		return 0
+	}
+
+	// The last page is the one with less than a full page.
+	return count/userListPageSize + 1
+}
@@ -0,0 +1,144 @@
+package malscraper
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		m, err := New(Config{})
+		assert.NoError(t, err)
+		assert.Empty(t, m.Plan().Requests)
+		assert.Empty(t, m.Plan().Keys)
+		m.ResetPlan()
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		m, err := New(Config{DryRun: true})
+		assert.NoError(t, err)
+		assert.NoError(t, m.cacher.Set(internal.GetKey(internal.KeyAnime, 2), &model.Anime{ID: 2}))
+
+		_, code, err := m.GetAnime(1)
+		assert.Equal(t, errors.ErrDryRun, err)
+		assert.Equal(t, 202, code)
+
+		d, _, err := m.GetAnime(2)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, d.ID)
+
+		_, _, err = m.GetUserAnime("rl404", -1)
+		assert.Equal(t, errors.ErrDryRun, err)
+
+		// Not negative cached.
+		_, _, err = m.GetAnime(1)
+		assert.Equal(t, errors.ErrDryRun, err)
+		assert.Empty(t, m.ListNegativeCache())
+
+		assert.Equal(t, model.Plan{
+			Requests: []model.PlanRequest{
+				{URL: "https://myanimelist.net/anime/1"},
+				{URL: "https://myanimelist.net/animelist/rl404/load.json?offset=0&order=0&status=7&tag=", Estimated: true},
+			},
+			Keys: []model.PlanKey{
+				{Prefix: internal.KeyAnime, Hit: 1, Miss: 1},
+				{Prefix: internal.KeyUserAnime, Miss: 1},
+			},
+		}, m.Plan())
+
+		m.ResetPlan()
+		assert.Empty(t, m.Plan().Requests)
+	})
+}
+
+func TestPlanMultiple(t *testing.T) {
+	animeListURL := "https://myanimelist.net/animelist/%s/load.json?offset=0&order=0&status=7&tag="
+
+	t.Run("compare", func(t *testing.T) {
+		m, err := New(Config{DryRun: true})
+		assert.NoError(t, err)
+
+		_, code, err := m.CompareUserAnime("rl404", "Xinil")
+		assert.Equal(t, errors.ErrDryRun, err)
+		assert.Equal(t, 202, code)
+
+		assert.Equal(t, []model.PlanRequest{
+			{URL: fmt.Sprintf(animeListURL, "rl404"), Estimated: true},
+			{URL: fmt.Sprintf(animeListURL, "Xinil"), Estimated: true},
+		}, m.Plan().Requests)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		m, err := New(Config{DryRun: true})
+		assert.NoError(t, err)
+		assert.NoError(t, m.cacher.Set(internal.GetKey(internal.KeyUserStats, "rl404"), &model.UserStats{
+			Anime: model.UserAnimeStats{Completed: 300, Total: 650},
+		}))
+
+		_, _, err = m.GetUserAnime("rl404", -1)
+		assert.Equal(t, errors.ErrDryRun, err)
+		_, _, err = m.GetUserAnimeAdv(model.UserListQuery{Username: "rl404", Status: StatusCompleted, Page: -1})
+		assert.Equal(t, errors.ErrDryRun, err)
+		_, _, err = m.GetUserManga("rl404", -1)
+		assert.Equal(t, errors.ErrDryRun, err)
+
+		assert.Equal(t, []model.PlanRequest{
+			{URL: fmt.Sprintf(animeListURL, "rl404"), Estimated: true, Pages: 3},
+			{URL: "https://myanimelist.net/animelist/rl404/load.json?offset=0&order=0&status=2&tag=", Estimated: true, Pages: 2},
+			{URL: "https://myanimelist.net/mangalist/rl404/load.json?offset=0&order=0&status=7&tag=", Estimated: true, Pages: 1},
+		}, m.Plan().Requests)
+	})
+
+	t.Run("breakdown", func(t *testing.T) {
+		m, err := New(Config{DryRun: true})
+		assert.NoError(t, err)
+		assert.NoError(t, m.cacher.Set(internal.GetKey(internal.KeyUserAnime, "rl404", -1, StatusAll, 0, ""), []model.UserAnime{{ID: 1}, {ID: 2}, {ID: 3}}))
+		assert.NoError(t, m.cacher.Set(internal.GetKey(internal.KeyAnime, 2), &model.Anime{ID: 2}))
+
+		_, code, err := m.GetUserAnimeBreakdown("rl404")
+		assert.Equal(t, errors.ErrDryRun, err)
+		assert.Equal(t, 202, code)
+
+		assert.Equal(t, []model.PlanRequest{
+			{URL: "https://myanimelist.net/anime/1"},
+			{URL: "https://myanimelist.net/anime/3"},
+		}, m.Plan().Requests)
+	})
+
+	t.Run("schedule", func(t *testing.T) {
+		m, err := New(Config{DryRun: true})
+		assert.NoError(t, err)
+
+		code, err := m.GetSeasonSchedule(ioutil.Discard, time.UTC, Winter, 2021)
+		assert.Equal(t, errors.ErrDryRun, err)
+		assert.Equal(t, 202, code)
+
+		requests := m.Plan().Requests
+		assert.Len(t, requests, 1)
+		assert.True(t, requests[0].Estimated)
+
+		assert.NoError(t, m.cacher.Set(internal.GetKey(internal.KeySeason, Winter, 2021), []model.AnimeItem{
+			{ID: 1, Type: model.AnimeTypeTV},
+			{ID: 2, Type: model.AnimeTypeMovie},
+			{ID: 3, Type: model.AnimeTypeTV},
+		}))
+		m.ResetPlan()
+
+		code, err = m.GetSeasonSchedule(ioutil.Discard, time.UTC, Winter, 2021)
+		assert.Equal(t, errors.ErrDryRun, err)
+		assert.Equal(t, 202, code)
+
+		// Requested concurrently.
+		assert.ElementsMatch(t, []model.PlanRequest{
+			{URL: "https://myanimelist.net/anime/1"},
+			{URL: "https://myanimelist.net/anime/3"},
+		}, m.Plan().Requests)
+	})
+}
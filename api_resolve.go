@@ -0,0 +1,223 @@
+package malscraper
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/utils"
+)
+
+// Default minimum confidence of resolver best match.
+const defaultMinConfidence = 0.8
+
+// Maximum number of search results which details
+// are parsed and compared by the resolver.
+const maxResolveCandidates = 5
+
+// ResolveAnime to get anime ID from its title. Useful when you
+// have an anime title from other site and need the MyAnimeList ID.
+//
+// Anime will be searched and the top search results' details will be
+// compared to the title (including english, synonym, and japanese title).
+// Optional hint (year, type, episode count) will be used to adjust
+// the confidence. Best match will be nil if there is no candidate
+// with confidence higher than the hint's `MinConfidence` (default 0.8).
+// Returns `ErrCanceled` if `ctx` is canceled or its deadline is
+// exceeded before all candidates are compared.
+//
+// Type should be one of these constants.
+//
+//  TypeTV
+//  TypeOVA
+//  TypeMovie
+//  TypeSpecial
+//  TypeONA
+//  TypeMusic
+func (m *Malscraper) ResolveAnime(ctx context.Context, title string, hint ...model.ResolveHint) (*model.ResolveResult, int, error) {
+	if err := ctx.Err(); err != nil {
+		code, err := getContextError(err)
+		return nil, code, err
+	}
+
+	h := getResolveHint(hint)
+
+	list, code, err := m.AdvSearchAnime(model.Query{Title: title})
+	if err != nil {
+		return nil, code, err
+	}
+
+	// Keep MyAnimeList search order which also considers
+	// english and synonym titles.
+	if len(list) > maxResolveCandidates {
+		list = list[:maxResolveCandidates]
+	}
+
+	candidates := []model.ResolveCandidate{}
+	for _, a := range list {
+		if err := ctx.Err(); err != nil {
+			code, err := getContextError(err)
+			return nil, code, err
+		}
+
+		d, code, err := m.api.GetAnime(a.ID)
+		if err != nil {
+			if code == http.StatusNotFound {
+				continue
+			}
+			return nil, code, err
+		}
+
+		candidates = append(candidates, model.ResolveCandidate{
+			ID:    d.ID,
+			Title: d.Title,
+			Confidence: getConfidence(title, h,
+				d.AiringDate.Start.Year, int(d.Type), d.Episode,
+				d.Title, d.AlternativeTitles),
+		})
+	}
+
+	return newResolveResult(candidates, h), http.StatusOK, nil
+}
+
+// ResolveManga to get manga ID from its title. Useful when you
+// have a manga title from other site and need the MyAnimeList ID.
+//
+// Manga will be searched and the top search results' details will be
+// compared to the title (including english, synonym, and japanese title).
+// Optional hint (year, type, chapter count) will be used to adjust
+// the confidence. Best match will be nil if there is no candidate
+// with confidence higher than the hint's `MinConfidence` (default 0.8).
+// Returns `ErrCanceled` if `ctx` is canceled or its deadline is
+// exceeded before all candidates are compared.
+//
+// Type should be one of these constants.
+//
+//  TypeManga
+//  TypeLightNovel
+//  TypeOneShot
+//  TypeDoujinshi
+//  TypeManhwa
+//  TypeManhua
+//  TypeNovel
+func (m *Malscraper) ResolveManga(ctx context.Context, title string, hint ...model.ResolveHint) (*model.ResolveResult, int, error) {
+	if err := ctx.Err(); err != nil {
+		code, err := getContextError(err)
+		return nil, code, err
+	}
+
+	h := getResolveHint(hint)
+
+	list, code, err := m.AdvSearchManga(model.Query{Title: title})
+	if err != nil {
+		return nil, code, err
+	}
+
+	// Keep MyAnimeList search order which also considers
+	// english and synonym titles.
+	if len(list) > maxResolveCandidates {
+		list = list[:maxResolveCandidates]
+	}
+
+	candidates := []model.ResolveCandidate{}
+	for _, a := range list {
+		if err := ctx.Err(); err != nil {
+			code, err := getContextError(err)
+			return nil, code, err
+		}
+
+		d, code, err := m.api.GetManga(a.ID)
+		if err != nil {
+			if code == http.StatusNotFound {
+				continue
+			}
+			return nil, code, err
+		}
+
+		candidates = append(candidates, model.ResolveCandidate{
+			ID:    d.ID,
+			Title: d.Title,
+			Confidence: getConfidence(title, h,
+				d.PublishingDate.Start.Year, int(d.Type), d.Chapter,
+				d.Title, d.AlternativeTitles),
+		})
+	}
+
+	return newResolveResult(candidates, h), http.StatusOK, nil
+}
+
+func getResolveHint(hint []model.ResolveHint) model.ResolveHint {
+	var h model.ResolveHint
+	if len(hint) > 0 {
+		h = hint[0]
+	}
+	if h.MinConfidence <= 0 {
+		h.MinConfidence = defaultMinConfidence
+	}
+	return h
+}
+
+// Non-standard status code for request canceled by
+// the client (the same as nginx).
+const statusClientClosedRequest = 499
+
+// getContextError to convert context error to malscraper
+// error and its status code.
+func getContextError(err error) (int, error) {
+	if err == context.DeadlineExceeded {
+		return http.StatusGatewayTimeout, errors.ErrCanceled
+	}
+	return statusClientClosedRequest, errors.ErrCanceled
+}
+
+// getConfidence to get the highest title similarity among the main
+// and alternative titles, then adjusted by the hint.
+func getConfidence(title string, h model.ResolveHint, year, _type, episode int, mainTitle string, alt model.AlternativeTitle) float64 {
+	titles := []string{mainTitle, alt.English, alt.Japanese}
+	titles = append(titles, strings.Split(alt.Synonym, ", ")...)
+
+	var confidence float64
+	for _, t := range titles {
+		if t = strings.TrimSpace(t); t == "" {
+			continue
+		}
+		confidence = math.Max(confidence, utils.Similarity(title, t))
+	}
+
+	if h.Year > 0 && year > 0 {
+		switch diff := h.Year - year; {
+		case diff == 0:
+		case diff == 1 || diff == -1:
+			confidence *= 0.95
+		default:
+			confidence *= 0.85
+		}
+	}
+
+	if h.Type > 0 && _type > 0 && h.Type != _type {
+		confidence *= 0.85
+	}
+
+	if h.Episode > 0 && episode > 0 && h.Episode != episode {
+		confidence *= 0.9
+	}
+
+	return math.Round(confidence*1000) / 1000
+}
+
+func newResolveResult(candidates []model.ResolveCandidate, h model.ResolveHint) *model.ResolveResult {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+
+	result := &model.ResolveResult{Candidates: candidates}
+	if len(candidates) > 0 && candidates[0].Confidence >= h.MinConfidence {
+		result.Best = &candidates[0]
+	}
+
+	return result
+}
@@ -0,0 +1,72 @@
+package malscraper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveAnime(t *testing.T) {
+	d, code, err := mal.ResolveAnime(context.Background(), "Cowboy Bebop", model.ResolveHint{
+		Year: 1998,
+		Type: TypeTV,
+	})
+	require.NotNil(t, d)
+	require.Equal(t, code, http.StatusOK)
+	require.NoError(t, err)
+
+	require.NotNil(t, d.Best)
+	assert.Equal(t, d.Best.ID, 1)
+	assert.GreaterOrEqual(t, d.Best.Confidence, 0.8)
+	assert.NotZero(t, len(d.Candidates))
+	time.Sleep(sleepDur)
+}
+
+func TestResolveManga(t *testing.T) {
+	d, code, err := mal.ResolveManga(context.Background(), "Monster", model.ResolveHint{
+		Type:    TypeManga,
+		Episode: 162,
+	})
+	require.NotNil(t, d)
+	require.Equal(t, code, http.StatusOK)
+	require.NoError(t, err)
+
+	require.NotNil(t, d.Best)
+	assert.Equal(t, d.Best.ID, 1)
+	assert.GreaterOrEqual(t, d.Best.Confidence, 0.8)
+	time.Sleep(sleepDur)
+}
+
+func TestResolveCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Canceled before searching so MyAnimeList is not requested.
+	m, _ := New(Config{DryRun: true})
+
+	d, code, err := m.ResolveAnime(ctx, "Cowboy Bebop")
+	require.Nil(t, d)
+	require.Equal(t, 499, code)
+	require.Equal(t, errors.ErrCanceled, err)
+
+	dm, code, err := m.ResolveManga(ctx, "Monster")
+	require.Nil(t, dm)
+	require.Equal(t, 499, code)
+	require.Equal(t, errors.ErrCanceled, err)
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now())
+	defer cancel()
+
+	d, code, err = m.ResolveAnime(ctx, "Cowboy Bebop")
+	require.Nil(t, d)
+	require.Equal(t, http.StatusGatewayTimeout, code)
+	require.Equal(t, errors.ErrCanceled, err)
+
+	require.Empty(t, m.Plan().Requests)
+}
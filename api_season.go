@@ -1,9 +1,14 @@
 package malscraper
 
 import (
+	"io"
+	"net/http"
+	"sync"
 	"time"
 
+	"github.com/rl404/go-malscraper/errors"
 	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/schedule"
 	"github.com/rl404/go-malscraper/pkg/utils"
 )
 
@@ -33,3 +38,101 @@ func (m *Malscraper) GetSeason(seasonYear ...interface{}) ([]model.AnimeItem, in
 	}
 	return m.api.GetSeason(season, year)
 }
+
+// GetSeasonSchedule to write seasonal TV anime weekly airing
+// schedule as iCalendar (.ics) feed in `loc` time zone.
+//
+// Anime details will be parsed to get the broadcast time and airing
+// date so it may take a while if not cached yet. At most 3 anime
+// details are parsed at the same time.
+//
+// Season should be one of these constants.
+//
+//  Winter
+//  Spring
+//  Summer
+//  Fall
+//
+// Example: https://myanimelist.net/anime/season.
+func (m *Malscraper) GetSeasonSchedule(w io.Writer, loc *time.Location, seasonYear ...interface{}) (int, error) {
+	n := m.planLen()
+	list, code, err := m.GetSeason(seasonYear...)
+	if err != nil {
+		if err == errors.ErrDryRun {
+			// Anime details requests are unknown yet.
+			m.estimateFrom(n)
+		}
+		return code, err
+	}
+
+	var ids []int
+	for _, a := range list {
+		if a.Type == model.AnimeTypeTV {
+			ids = append(ids, a.ID)
+		}
+	}
+
+	var animes []model.Anime
+	var dryRun bool
+	for _, r := range m.getAnimes(ids, scheduleConcurrency) {
+		if r.err != nil {
+			if r.code == http.StatusNotFound {
+				continue
+			}
+			if r.err == errors.ErrDryRun {
+				// Continue to record the other requests.
+				dryRun = true
+				continue
+			}
+			return r.code, r.err
+		}
+
+		animes = append(animes, *r.data)
+	}
+
+	if dryRun {
+		return http.StatusAccepted, errors.ErrDryRun
+	}
+
+	if err := schedule.WriteICS(w, animes, loc); err != nil {
+		m.logger.Error("failed writing ics: %s", err.Error())
+		return http.StatusInternalServerError, errors.ErrWriteICS
+	}
+
+	return http.StatusOK, nil
+}
+
+// Maximum number of anime details which are
+// parsed at the same time in GetSeasonSchedule.
+const scheduleConcurrency = 3
+
+type animeResult struct {
+	data *model.Anime
+	code int
+	err  error
+}
+
+// getAnimes to get anime details concurrently with at most
+// `limit` details parsed at the same time. Results are in the
+// same order as the ids.
+func (m *Malscraper) getAnimes(ids []int, limit int) []animeResult {
+	results := make([]animeResult, len(ids))
+	sem := make(chan struct{}, limit)
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i, id int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			d, code, err := m.api.GetAnime(id)
+			results[i] = animeResult{data: d, code: code, err: err}
+		}(i, id)
+	}
+	wg.Wait()
+
+	return results
+}
@@ -1,10 +1,14 @@
 package malscraper
 
 import (
+	"bytes"
 	"net/http"
 	"testing"
 	"time"
 
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -80,3 +84,26 @@ func TestGetSeason(t *testing.T) {
 	assert.False(t, emptyProd)
 	time.Sleep(sleepDur)
 }
+
+func TestGetSeasonSchedule(t *testing.T) {
+	var buf bytes.Buffer
+	code, err := mal.GetSeasonSchedule(&buf, time.UTC, Spring, 2021)
+	require.Equal(t, code, http.StatusOK)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "BEGIN:VCALENDAR")
+	assert.Contains(t, buf.String(), "BEGIN:VEVENT")
+	time.Sleep(sleepDur)
+}
+
+func TestGetAnimes(t *testing.T) {
+	m, err := New(Config{DryRun: true})
+	require.NoError(t, err)
+	require.NoError(t, m.cacher.Set(internal.GetKey(internal.KeyAnime, 2), &model.Anime{ID: 2}))
+
+	results := m.getAnimes([]int{1, 2, 3}, 2)
+	require.Len(t, results, 3)
+	assert.Equal(t, errors.ErrDryRun, results[0].err)
+	assert.Equal(t, 2, results[1].data.ID)
+	assert.Equal(t, errors.ErrDryRun, results[2].err)
+}
@@ -0,0 +1,209 @@
+package malscraper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal/parser"
+	"github.com/rl404/go-malscraper/model"
+)
+
+// ParseURL to parse MyAnimeList URL to get its entity, ID (or username),
+// sub-page and page number. Both absolute and relative URL are accepted.
+//
+//  https://myanimelist.net/anime/1/Cowboy_Bebop/reviews?p=2
+//  /profile/xinil/friends
+//
+// Entity will be one of these constants.
+//
+//  EntityAnime       EntityNews
+//  EntityManga       EntityArticle
+//  EntityCharacter   EntityReview
+//  EntityPeople      EntityProducer
+//  EntityUser        EntityMagazine
+//  EntityClub        EntityAnimeGenre
+//                    EntityMangaGenre
+func ParseURL(url string) (*model.URLInfo, error) {
+	return parser.ParseURL(url)
+}
+
+// URLFor to build canonical MyAnimeList URL of the entity.
+// The id should be a username (string) for EntityUser and
+// an int for the other entities. Leave sub-page empty for
+// the entity's main page.
+//
+// Available sub-pages.
+//
+//  Entity            Sub-page
+//  ---------------   --------------------------------------------------------
+//  EntityAnime       characters, video, episode, stats, reviews, userrecs,
+//                    news, featured, clubs, pics, moreinfo
+//  EntityManga       characters, stats, reviews, userrecs, news, featured,
+//                    clubs, pics, moreinfo
+//  EntityCharacter   featured, pictures, clubs
+//  EntityPeople      news, featured, pictures
+//  EntityUser        friends, reviews, recommendations, clubs, history,
+//                    animelist, mangalist
+//  EntityClub        members, pictures
+func URLFor(entity string, id interface{}, subPage string) (string, error) {
+	return parser.URLFor(entity, id, subPage)
+}
+
+// Dispatch to parse MyAnimeList URL and call the matching method.
+// The returned data type depends on the entity and sub-page. For example,
+// `/anime/1/Cowboy_Bebop/reviews?p=2` will return the same data as
+// `GetAnimeReview(1, 2)`.
+func (m *Malscraper) Dispatch(ctx context.Context, url string) (interface{}, int, error) {
+	u, err := ParseURL(url)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, http.StatusRequestTimeout, err
+	}
+
+	switch u.Entity {
+	case EntityAnime:
+		return m.dispatchAnime(u)
+	case EntityManga:
+		return m.dispatchManga(u)
+	case EntityCharacter:
+		return m.dispatchCharacter(u)
+	case EntityPeople:
+		return m.dispatchPeople(u)
+	case EntityUser:
+		return m.dispatchUser(u)
+	case EntityClub:
+		return m.dispatchClub(u)
+	case EntityNews:
+		return m.api.GetNews(u.ID)
+	case EntityArticle:
+		return m.api.GetArticle(u.ID)
+	case EntityReview:
+		return m.api.GetReview(u.ID)
+	case EntityProducer:
+		return m.api.GetProducer(u.ID, u.Page)
+	case EntityMagazine:
+		return m.api.GetMagazine(u.ID, u.Page)
+	case EntityAnimeGenre:
+		return m.api.GetAnimeWithGenre(u.ID, u.Page)
+	case EntityMangaGenre:
+		return m.api.GetMangaWithGenre(u.ID, u.Page)
+	}
+
+	return nil, http.StatusBadRequest, errors.ErrInvalidURL
+}
+
+func (m *Malscraper) dispatchAnime(u *model.URLInfo) (interface{}, int, error) {
+	switch u.SubPage {
+	case "characters":
+		return m.api.GetAnimeCharacter(u.ID)
+	case "video":
+		return m.api.GetAnimeVideo(u.ID, u.Page)
+	case "episode":
+		return m.api.GetAnimeEpisode(u.ID, u.Page)
+	case "stats":
+		return m.api.GetAnimeStats(u.ID)
+	case "reviews":
+		return m.api.GetAnimeReview(u.ID, u.Page)
+	case "userrecs":
+		return m.api.GetAnimeRecommendation(u.ID)
+	case "news":
+		return m.api.GetAnimeNews(u.ID)
+	case "featured":
+		return m.api.GetAnimeArticle(u.ID)
+	case "clubs":
+		return m.api.GetAnimeClub(u.ID)
+	case "pics":
+		return m.api.GetAnimePicture(u.ID)
+	case "moreinfo":
+		return m.api.GetAnimeMoreInfo(u.ID)
+	default:
+		return m.api.GetAnime(u.ID)
+	}
+}
+
+func (m *Malscraper) dispatchManga(u *model.URLInfo) (interface{}, int, error) {
+	switch u.SubPage {
+	case "characters":
+		return m.api.GetMangaCharacter(u.ID)
+	case "stats":
+		return m.api.GetMangaStats(u.ID)
+	case "reviews":
+		return m.api.GetMangaReview(u.ID, u.Page)
+	case "userrecs":
+		return m.api.GetMangaRecommendation(u.ID)
+	case "news":
+		return m.api.GetMangaNews(u.ID)
+	case "featured":
+		return m.api.GetMangaArticle(u.ID)
+	case "clubs":
+		return m.api.GetMangaClub(u.ID)
+	case "pics":
+		return m.api.GetMangaPicture(u.ID)
+	case "moreinfo":
+		return m.api.GetMangaMoreInfo(u.ID)
+	default:
+		return m.api.GetManga(u.ID)
+	}
+}
+
+func (m *Malscraper) dispatchCharacter(u *model.URLInfo) (interface{}, int, error) {
+	switch u.SubPage {
+	case "featured":
+		return m.api.GetCharacterArticle(u.ID)
+	case "pictures":
+		return m.api.GetCharacterPicture(u.ID)
+	case "clubs":
+		return m.api.GetCharacterClub(u.ID)
+	default:
+		return m.api.GetCharacter(u.ID)
+	}
+}
+
+func (m *Malscraper) dispatchPeople(u *model.URLInfo) (interface{}, int, error) {
+	switch u.SubPage {
+	case "news":
+		return m.api.GetPeopleNews(u.ID)
+	case "featured":
+		return m.api.GetPeopleArticle(u.ID)
+	case "pictures":
+		return m.api.GetPeoplePicture(u.ID)
+	default:
+		return m.api.GetPeople(u.ID)
+	}
+}
+
+func (m *Malscraper) dispatchUser(u *model.URLInfo) (interface{}, int, error) {
+	switch u.SubPage {
+	case "friends":
+		return m.api.GetUserFriend(u.Username, u.Page)
+	case "reviews":
+		return m.api.GetUserReview(u.Username, u.Page)
+	case "recommendations":
+		return m.api.GetUserRecommendation(u.Username, u.Page)
+	case "clubs":
+		return m.api.GetUserClub(u.Username)
+	case "history":
+		return m.api.GetUserHistory(u.Username, "")
+	case "animelist":
+		return m.api.GetUserAnime(model.UserListQuery{Username: u.Username, Status: StatusAll, Page: u.Page})
+	case "mangalist":
+		return m.api.GetUserManga(model.UserListQuery{Username: u.Username, Status: StatusAll, Page: u.Page})
+	default:
+		return m.api.GetUser(u.Username)
+	}
+}
+
+func (m *Malscraper) dispatchClub(u *model.URLInfo) (interface{}, int, error) {
+	switch u.SubPage {
+	case "members":
+		return m.api.GetClubMember(u.ID, u.Page)
+	case "pictures":
+		return m.api.GetClubPicture(u.ID)
+	default:
+		return m.api.GetClub(u.ID)
+	}
+}
@@ -0,0 +1,111 @@
+package malscraper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		info *model.URLInfo
+		err  error
+	}{
+		{url: "https://myanimelist.net/anime/1/Cowboy_Bebop/reviews?p=2", info: &model.URLInfo{Entity: EntityAnime, ID: 1, SubPage: "reviews", Page: 2}},
+		{url: "https://myanimelist.net/anime/1/Cowboy_Bebop/episode?offset=100", info: &model.URLInfo{Entity: EntityAnime, ID: 1, SubPage: "episode", Page: 2}},
+		{url: "https://myanimelist.net/anime/1/reviews", info: &model.URLInfo{Entity: EntityAnime, ID: 1, SubPage: "reviews", Page: 1}},
+		{url: "https://myanimelist.net/anime/1/episode?offset=100", info: &model.URLInfo{Entity: EntityAnime, ID: 1, SubPage: "episode", Page: 2}},
+		{url: "https://myanimelist.net/anime/1/Cowboy_Bebop", info: &model.URLInfo{Entity: EntityAnime, ID: 1, Page: 1}},
+		{url: "https://myanimelist.net/people/1/pictures", info: &model.URLInfo{Entity: EntityPeople, ID: 1, SubPage: "pictures", Page: 1}},
+		{url: "myanimelist.net/manga/2", info: &model.URLInfo{Entity: EntityManga, ID: 2, Page: 1}},
+		{url: "/profile/xinil/friends", info: &model.URLInfo{Entity: EntityUser, Username: "xinil", SubPage: "friends", Page: 1}},
+		{url: "https://myanimelist.net/animelist/xinil", info: &model.URLInfo{Entity: EntityUser, Username: "xinil", SubPage: "animelist", Page: 1}},
+		{url: "https://myanimelist.net/character/1/Spike_Spiegel/pictures", info: &model.URLInfo{Entity: EntityCharacter, ID: 1, SubPage: "pictures", Page: 1}},
+		{url: "https://myanimelist.net/clubs.php?cid=1", info: &model.URLInfo{Entity: EntityClub, ID: 1, Page: 1}},
+		{url: "https://myanimelist.net/clubs.php?action=view&t=members&id=1&show=36", info: &model.URLInfo{Entity: EntityClub, ID: 1, SubPage: "members", Page: 2}},
+		{url: "https://myanimelist.net/reviews.php?id=1", info: &model.URLInfo{Entity: EntityReview, ID: 1, Page: 1}},
+		{url: "https://myanimelist.net/featured/2321/slug", info: &model.URLInfo{Entity: EntityArticle, ID: 2321, Page: 1}},
+		{url: "https://myanimelist.net/anime/genre/1/Action?page=2", info: &model.URLInfo{Entity: EntityAnimeGenre, ID: 1, Page: 2}},
+		{url: "https://myanimelist.net/anime/producer/14", info: &model.URLInfo{Entity: EntityProducer, ID: 14, Page: 1}},
+		{url: "https://example.com/anime/1", err: errors.ErrInvalidURL},
+		{url: "https://myanimelist.net/anime/1/Cowboy_Bebop/unknown", err: errors.ErrInvalidURL},
+		{url: "https://myanimelist.net/anime/abc", err: errors.ErrInvalidID},
+		{url: "https://myanimelist.net/profile", err: errors.ErrInvalidUsername},
+		{url: "", err: errors.ErrInvalidURL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.url, func(t *testing.T) {
+			info, err := ParseURL(test.url)
+			assert.Equal(t, test.info, info)
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestURLFor(t *testing.T) {
+	tests := []struct {
+		entity  string
+		id      interface{}
+		subPage string
+		url     string
+		err     error
+	}{
+		{entity: EntityAnime, id: 1, url: "https://myanimelist.net/anime/1"},
+		{entity: EntityAnime, id: 1, subPage: "reviews", url: "https://myanimelist.net/anime/1/a/reviews"},
+		{entity: EntityUser, id: "xinil", subPage: "friends", url: "https://myanimelist.net/profile/xinil/friends"},
+		{entity: EntityUser, id: "xinil", subPage: "history", url: "https://myanimelist.net/history/xinil"},
+		{entity: EntityClub, id: 1, subPage: "members", url: "https://myanimelist.net/clubs.php?action=view&id=1&t=members"},
+		{entity: EntityArticle, id: 2321, url: "https://myanimelist.net/featured/2321"},
+		{entity: EntityMangaGenre, id: 1, url: "https://myanimelist.net/manga/genre/1"},
+		{entity: EntityAnime, id: 1, subPage: "unknown", err: errors.ErrInvalidURL},
+		{entity: EntityAnime, id: "1", err: errors.ErrInvalidID},
+		{entity: EntityUser, id: 1, err: errors.ErrInvalidUsername},
+		{entity: "unknown", id: 1, err: errors.ErrInvalidURL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.entity+"/"+test.subPage, func(t *testing.T) {
+			url, err := URLFor(test.entity, test.id, test.subPage)
+			assert.Equal(t, test.url, url)
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestURLForParseURL(t *testing.T) {
+	url, err := URLFor(EntityManga, 2, "characters")
+	require.NoError(t, err)
+
+	info, err := ParseURL(url)
+	require.NoError(t, err)
+	assert.Equal(t, &model.URLInfo{Entity: EntityManga, ID: 2, SubPage: "characters", Page: 1}, info)
+}
+
+func TestDispatch(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		d, code, err := mal.Dispatch(context.Background(), "https://example.com")
+		require.Nil(t, d)
+		require.Equal(t, code, http.StatusBadRequest)
+		require.Equal(t, err, errors.ErrInvalidURL)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		d, code, err := mal.Dispatch(context.Background(), "https://myanimelist.net/anime/1/Cowboy_Bebop/reviews?p=2")
+		require.NotNil(t, d)
+		require.Equal(t, code, http.StatusOK)
+		require.NoError(t, err)
+
+		reviews, ok := d.([]model.Review)
+		require.True(t, ok)
+		assert.NotZero(t, len(reviews))
+		time.Sleep(sleepDur)
+	})
+}
@@ -1,7 +1,13 @@
 package malscraper
 
 import (
+	"net/http"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal"
 	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/breakdown"
+	"github.com/rl404/go-malscraper/pkg/compare"
 )
 
 // GetUser to get user detail information.
@@ -117,7 +123,13 @@ func (m *Malscraper) GetUserAnimeAdv(query model.UserListQuery) ([]model.UserAni
 	if query.Page == 0 {
 		query.Page = 1
 	}
-	return m.api.GetUserAnime(query)
+
+	n := m.planLen()
+	list, code, err := m.api.GetUserAnime(query)
+	if err == errors.ErrDryRun && query.Page == -1 {
+		m.estimatePages(n, m.getUserListPages(query.Username, query.Status, true))
+	}
+	return list, code, err
 }
 
 // GetUserManga to quick get user manga list.
@@ -154,5 +166,127 @@ func (m *Malscraper) GetUserMangaAdv(query model.UserListQuery) ([]model.UserMan
 	if query.Page == 0 {
 		query.Page = 1
 	}
-	return m.api.GetUserManga(query)
+
+	n := m.planLen()
+	list, code, err := m.api.GetUserManga(query)
+	if err == errors.ErrDryRun && query.Page == -1 {
+		m.estimatePages(n, m.getUserListPages(query.Username, query.Status, false))
+	}
+	return list, code, err
+}
+
+// CompareUserAnime to compare 2 users' whole anime list.
+//
+// Use `compare.Anime()` in `pkg/compare` to compare
+// already-fetched list.
+//
+// Example: https://myanimelist.net/shared.php?u1=rl404&u2=Xinil.
+func (m *Malscraper) CompareUserAnime(username1, username2 string) (*model.UserCompare, int, error) {
+	list1, code, err1 := m.GetUserAnime(username1, -1)
+	if err1 != nil && err1 != errors.ErrDryRun {
+		return nil, code, err1
+	}
+
+	// Continue in dry-run mode to record the other user's requests.
+	list2, code, err := m.GetUserAnime(username2, -1)
+	if err != nil {
+		return nil, code, err
+	}
+
+	if err1 != nil {
+		return nil, http.StatusAccepted, err1
+	}
+
+	result := compare.Anime(list1, list2)
+	return &result, http.StatusOK, nil
+}
+
+// CompareUserManga to compare 2 users' whole manga list.
+//
+// Use `compare.Manga()` in `pkg/compare` to compare
+// already-fetched list.
+//
+// Example: https://myanimelist.net/shared.php?type=manga&u1=rl404&u2=Xinil.
+func (m *Malscraper) CompareUserManga(username1, username2 string) (*model.UserCompare, int, error) {
+	list1, code, err1 := m.GetUserManga(username1, -1)
+	if err1 != nil && err1 != errors.ErrDryRun {
+		return nil, code, err1
+	}
+
+	// Continue in dry-run mode to record the other user's requests.
+	list2, code, err := m.GetUserManga(username2, -1)
+	if err != nil {
+		return nil, code, err
+	}
+
+	if err1 != nil {
+		return nil, http.StatusAccepted, err1
+	}
+
+	result := compare.Manga(list1, list2)
+	return &result, http.StatusOK, nil
+}
+
+// Default maximum number of anime details which
+// are parsed in GetUserAnimeBreakdown.
+const defaultBreakdownLimit = 50
+
+// GetUserAnimeBreakdown to get user anime list breakdown by genre,
+// studio, season, and type.
+//
+// Anime details will be taken from cache first. Param `limit` is
+// the maximum number of anime details which are not in cache yet and
+// need to be parsed from MyAnimeList (default 50). Anime which details
+// are not parsed will be counted as missing.
+//
+// Example: https://myanimelist.net/animelist/rl404.
+func (m *Malscraper) GetUserAnimeBreakdown(username string, limit ...int) (*model.UserAnimeBreakdown, int, error) {
+	l := defaultBreakdownLimit
+	if len(limit) > 0 {
+		l = limit[0]
+	}
+
+	n := m.planLen()
+	list, code, err := m.GetUserAnime(username, -1)
+	if err != nil {
+		if err == errors.ErrDryRun {
+			// Anime details requests are unknown yet.
+			m.estimateFrom(n)
+		}
+		return nil, code, err
+	}
+
+	var parsed int
+	var dryRun bool
+	details := make(map[int]model.Anime)
+	for _, a := range list {
+		var d *model.Anime
+		if m.cacher.Get(internal.GetKey(internal.KeyAnime, a.ID), &d) != nil || d == nil {
+			if parsed >= l {
+				continue
+			}
+			parsed++
+
+			d, code, err = m.api.GetAnime(a.ID)
+			if err != nil {
+				if code == http.StatusNotFound {
+					continue
+				}
+				if err == errors.ErrDryRun {
+					// Continue to record the other requests.
+					dryRun = true
+					continue
+				}
+				return nil, code, err
+			}
+		}
+		details[a.ID] = *d
+	}
+
+	if dryRun {
+		return nil, http.StatusAccepted, errors.ErrDryRun
+	}
+
+	result := breakdown.Anime(list, details)
+	return &result, http.StatusOK, nil
 }
@@ -254,7 +254,7 @@ func TestGetUserAnime(t *testing.T) {
 		if a.Tag != "" {
 			emptyTag = false
 		}
-		if a.Rating != "" {
+		if a.Rating > 0 {
 			emptyRating = false
 		}
 		assert.NotZero(t, a.AiringStatus)
@@ -313,3 +313,42 @@ func TestGetUserManga(t *testing.T) {
 	assert.False(t, emptyTag)
 	time.Sleep(sleepDur)
 }
+
+func TestCompareUserAnime(t *testing.T) {
+	d, code, err := mal.CompareUserAnime("rl404", "Xinil")
+	require.NotNil(t, d)
+	require.Equal(t, code, http.StatusOK)
+	require.NoError(t, err)
+
+	assert.NotZero(t, len(d.Shared))
+	assert.NotZero(t, len(d.OnlyFirstCompleted))
+	assert.NotZero(t, len(d.OnlySecondCompleted))
+	assert.NotZero(t, d.FirstMeanScore)
+	assert.NotZero(t, d.SecondMeanScore)
+	assert.NotZero(t, d.Compatibility)
+	time.Sleep(sleepDur)
+}
+
+func TestCompareUserManga(t *testing.T) {
+	d, code, err := mal.CompareUserManga("rl404", "Xinil")
+	require.NotNil(t, d)
+	require.Equal(t, code, http.StatusOK)
+	require.NoError(t, err)
+
+	assert.NotZero(t, len(d.Shared))
+	time.Sleep(sleepDur)
+}
+
+func TestGetUserAnimeBreakdown(t *testing.T) {
+	d, code, err := mal.GetUserAnimeBreakdown("rl404", 5)
+	require.NotNil(t, d)
+	require.Equal(t, code, http.StatusOK)
+	require.NoError(t, err)
+
+	assert.NotZero(t, d.Total)
+	assert.NotZero(t, len(d.Genres))
+	assert.NotZero(t, len(d.Studios))
+	assert.NotZero(t, len(d.Seasons))
+	assert.NotZero(t, len(d.Types))
+	time.Sleep(sleepDur)
+}
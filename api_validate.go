@@ -0,0 +1,29 @@
+package malscraper
+
+import "github.com/rl404/go-malscraper/errors"
+
+// ValidateQuery to validate all fields of the query before using it
+// without requesting MyAnimeList. Search and user list methods validate
+// the query the same way. All invalid fields are returned at once as
+// `*errors.ValidationError`. Still can be checked with `errors.Is()`
+// for each field's error.
+//
+// Query should be one of these.
+//
+//  model.Query          for AdvSearchAnime() & AdvSearchManga()
+//  model.ClubQuery      for AdvSearchClub()
+//  model.UserQuery      for AdvSearchUser()
+//  model.UserListQuery  for GetUserAnimeAdv() & GetUserMangaAdv()
+//
+// Param `_type` should be either `AnimeType` (default) or `MangaType`
+// and only used for `model.Query` and `model.UserListQuery`.
+func (m *Malscraper) ValidateQuery(query interface{}, _type ...int) error {
+	t := AnimeType
+	if len(_type) > 0 {
+		t = _type[0]
+	}
+	if t < 0 || t >= len(mainTypes) {
+		return errors.ErrInvalidType
+	}
+	return m.validator.ValidateQuery(query, mainTypes[t])
+}
@@ -0,0 +1,28 @@
+package malscraper
+
+import (
+	e "errors"
+	"testing"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateQuery(t *testing.T) {
+	assert.NoError(t, mal.ValidateQuery(model.Query{Title: "naruto"}))
+	assert.NoError(t, mal.ValidateQuery(model.Query{Title: "naruto", Type: TypeManhwa}, MangaType))
+
+	err := mal.ValidateQuery(model.Query{Title: "na", Score: 11})
+	assert.True(t, e.Is(err, errors.Err3LettersSearch))
+	assert.True(t, e.Is(err, errors.ErrInvalidScore))
+
+	var vErr *errors.ValidationError
+	assert.True(t, e.As(err, &vErr))
+	assert.Len(t, vErr.Fields, 2)
+}
+
+func TestValidateQueryType(t *testing.T) {
+	assert.Equal(t, errors.ErrInvalidType, mal.ValidateQuery(model.Query{Title: "naruto"}, 5))
+	assert.Equal(t, errors.ErrInvalidType, mal.ValidateQuery(model.Query{Title: "naruto"}, AllType))
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"crypto/subtle"
+	e "errors"
+	"net/http"
+
+	"github.com/rl404/go-malscraper/errors"
+)
+
+// errUnauthorized is returned if admin route is requested
+// without the correct admin token.
+var errUnauthorized = e.New(http.StatusText(http.StatusUnauthorized))
+
+func (s *server) registerAdminRoutes() {
+	m, rt := s.mal, &s.router
+
+	// Dry-run plan.
+	rt.handle("/admin/plan", s.admin(func(p *params) (interface{}, int, error) { return m.Plan(), http.StatusOK, nil }))
+	rt.handleMethod(http.MethodDelete, "/admin/plan", s.admin(func(p *params) (interface{}, int, error) {
+		m.ResetPlan()
+		return nil, http.StatusOK, nil
+	}))
+
+	// Cache maintenance.
+	rt.handle("/admin/cache/negative", s.admin(func(p *params) (interface{}, int, error) {
+		return m.ListNegativeCache(p.queryStr("entity")), http.StatusOK, nil
+	}))
+	rt.handleMethod(http.MethodDelete, "/admin/cache/negative", s.admin(s.purgeNegativeCache))
+	rt.handleMethod(http.MethodPost, "/admin/cache/reparse", s.admin(s.reparseCache))
+}
+
+// admin to wrap handler which requires `Authorization: Bearer <token>`
+// header with the admin token.
+func (s *server) admin(h handlerFunc) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		token := []byte("Bearer " + s.adminToken)
+		if subtle.ConstantTimeCompare([]byte(p.r.Header.Get("Authorization")), token) != 1 {
+			return nil, http.StatusUnauthorized, errUnauthorized
+		}
+		return h(p)
+	}
+}
+
+func (s *server) purgeNegativeCache(p *params) (interface{}, int, error) {
+	cnt, err := s.mal.PurgeNegativeCache(p.queryStr("entity"))
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return cnt, http.StatusOK, nil
+}
+
+func (s *server) reparseCache(p *params) (interface{}, int, error) {
+	entity := p.queryStr("entity")
+	if entity == "" {
+		return nil, http.StatusBadRequest, &paramError{name: "entity"}
+	}
+
+	cnt, err := s.mal.ReparseCache(p.r.Context(), entity)
+	switch {
+	case err == errors.ErrNoPageCache:
+		return nil, http.StatusNotImplemented, err
+	case err != nil:
+		return nil, http.StatusInternalServerError, err
+	}
+	return cnt, http.StatusOK, nil
+}
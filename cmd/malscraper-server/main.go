@@ -0,0 +1,351 @@
+// Command malscraper-server is a REST API server which exposes
+// all malscraper methods as JSON routes.
+//
+// Usage:
+//
+//  malscraper-server [flags]
+//
+// Flags:
+//
+//  -addr                listen address (default ":8080")
+//  -cache               cache type, "memory", "file" or "none" (default "memory")
+//  -cache-dir           file cache directory (default "malscraper-cache")
+//  -cache-max-size      file cache maximum size in bytes, 0 is unlimited
+//  -cache-time          cache expired time (default 24h)
+//  -search-cache-time   search result cache expired time (default 1h)
+//  -stale-cache-time    keep expired cache to be used while MyAnimeList is unavailable
+//  -conditional         refresh expired cache with conditional request (ETag and Last-Modified)
+//  -breaker-threshold   open circuit breaker after this many consecutive failures, 0 is disabled
+//  -breaker-timeout     circuit breaker open duration (default 30s)
+//  -proxies             comma-separated proxy URLs to spread requests across
+//  -proxy-interval      minimum duration between requests through the same proxy
+//  -har-dir             record requests to MyAnimeList as HAR files in this directory
+//  -har-max-body        maximum recorded response body size in bytes, 0 is 1 MiB, negative is complete body
+//  -har-rotate          start a new HAR file after this duration (default 1h)
+//  -har-entities        comma-separated entities to record, empty is all
+//  -clean-image         clean image URL (default true)
+//  -clean-video         clean video URL (default true)
+//  -legacy-enum         encode anime & manga enums as string
+//  -load-reference      load validator reference data at startup and when needed
+//  -log-level           log level, 0-4 (default 1)
+//  -log-color           colorful log
+//  -dry-run             don't request MyAnimeList, only record planned requests
+//  -page-cache-dir      save raw MyAnimeList pages in this directory to be parsed again
+//  -page-cache-time     saved raw page expired time (default 168h)
+//  -admin-token         enable admin routes with this bearer token
+//  -shutdown-timeout    graceful shutdown timeout (default 10s)
+//
+// Every response is a JSON with format below. Validation error will
+// return 400 and other errors will return the code from malscraper.
+//
+//  {
+//    "status": 200,
+//    "message": "OK",
+//    "data": {}
+//  }
+//
+// Health and readiness check are available at `/healthz` and `/readyz`.
+// GraphQL endpoint is available at `/graphql`.
+//
+// Admin routes are only available if `-admin-token` is set and
+// require `Authorization: Bearer <token>` header.
+//
+//  GET    /admin/plan                     dry-run plan (requires -dry-run)
+//  DELETE /admin/plan                     reset dry-run plan
+//  GET    /admin/cache/negative?entity=   list negative cache
+//  DELETE /admin/cache/negative?entity=   purge negative cache
+//  POST   /admin/cache/reparse?entity=    parse saved raw pages again (requires -page-cache-dir)
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	malscraper "github.com/rl404/go-malscraper"
+	"github.com/rl404/go-malscraper/pkg/cache/filecache"
+	"github.com/rl404/go-malscraper/pkg/har"
+	"github.com/rl404/go-malscraper/pkg/proxypool"
+	"github.com/rl404/go-malscraper/service"
+	"github.com/rl404/mal-plugin/cache/nocache"
+	"github.com/rl404/mal-plugin/log/mallogger"
+)
+
+type config struct {
+	addr            string
+	cache           string
+	cacheDir        string
+	cacheMaxSize    int64
+	cacheTime       time.Duration
+	searchCacheTime time.Duration
+	staleCacheTime  time.Duration
+	conditional     bool
+	breakerThresh   int
+	breakerTimeout  time.Duration
+	proxies         string
+	proxyInterval   time.Duration
+	harDir          string
+	harMaxBody      int
+	harRotate       time.Duration
+	harEntities     string
+	cleanImage      bool
+	cleanVideo      bool
+	legacyEnum      bool
+	loadReference   bool
+	logLevel        int
+	logColor        bool
+	dryRun          bool
+	pageCacheDir    string
+	pageCacheTime   time.Duration
+	adminToken      string
+	shutdownTimeout time.Duration
+}
+
+func parseFlags() config {
+	var cfg config
+	flag.StringVar(&cfg.addr, "addr", ":8080", "listen address")
+	flag.StringVar(&cfg.cache, "cache", "memory", `cache type, "memory", "file" or "none"`)
+	flag.StringVar(&cfg.cacheDir, "cache-dir", "malscraper-cache", "file cache directory")
+	flag.Int64Var(&cfg.cacheMaxSize, "cache-max-size", 0, "file cache maximum size in bytes, 0 is unlimited")
+	flag.DurationVar(&cfg.cacheTime, "cache-time", 24*time.Hour, "cache expired time")
+	flag.DurationVar(&cfg.searchCacheTime, "search-cache-time", time.Hour, "search result cache expired time")
+	flag.DurationVar(&cfg.staleCacheTime, "stale-cache-time", 0, "keep expired cache to be used while MyAnimeList is unavailable")
+	flag.BoolVar(&cfg.conditional, "conditional", false, "refresh expired cache with conditional request (ETag and Last-Modified)")
+	flag.IntVar(&cfg.breakerThresh, "breaker-threshold", 0, "open circuit breaker after this many consecutive failures, 0 is disabled")
+	flag.DurationVar(&cfg.breakerTimeout, "breaker-timeout", 30*time.Second, "circuit breaker open duration")
+	flag.StringVar(&cfg.proxies, "proxies", "", "comma-separated proxy URLs to spread requests across")
+	flag.DurationVar(&cfg.proxyInterval, "proxy-interval", 0, "minimum duration between requests through the same proxy")
+	flag.StringVar(&cfg.harDir, "har-dir", "", "record requests to MyAnimeList as HAR files in this directory")
+	flag.IntVar(&cfg.harMaxBody, "har-max-body", 0, "maximum recorded response body size in bytes, 0 is 1 MiB, negative is complete body")
+	flag.DurationVar(&cfg.harRotate, "har-rotate", time.Hour, "start a new HAR file after this duration")
+	flag.StringVar(&cfg.harEntities, "har-entities", "", "comma-separated entities to record, empty is all")
+	flag.BoolVar(&cfg.cleanImage, "clean-image", true, "clean image URL")
+	flag.BoolVar(&cfg.cleanVideo, "clean-video", true, "clean video URL")
+	flag.BoolVar(&cfg.legacyEnum, "legacy-enum", false, "encode anime & manga enums as string")
+	flag.BoolVar(&cfg.loadReference, "load-reference", false, "load validator reference data at startup and when needed")
+	flag.IntVar(&cfg.logLevel, "log-level", malscraper.LevelDefault, "log level, 0-4")
+	flag.BoolVar(&cfg.logColor, "log-color", false, "colorful log")
+	flag.BoolVar(&cfg.dryRun, "dry-run", false, "don't request MyAnimeList, only record planned requests")
+	flag.StringVar(&cfg.pageCacheDir, "page-cache-dir", "", "save raw MyAnimeList pages in this directory to be parsed again")
+	flag.DurationVar(&cfg.pageCacheTime, "page-cache-time", 7*24*time.Hour, "saved raw page expired time")
+	flag.StringVar(&cfg.adminToken, "admin-token", "", "enable admin routes with this bearer token")
+	flag.DurationVar(&cfg.shutdownTimeout, "shutdown-timeout", 10*time.Second, "graceful shutdown timeout")
+	flag.Parse()
+	return cfg
+}
+
+func newHTTPClient(cfg config, logger service.Logger) (service.HTTPClient, *har.Recorder, error) {
+	var client service.HTTPClient
+	if cfg.proxies != "" {
+		c, err := proxypool.NewWithConfig(proxypool.Config{
+			Proxies:  strings.Split(cfg.proxies, ","),
+			Interval: cfg.proxyInterval,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		client = c
+	}
+
+	if cfg.harDir == "" {
+		return client, nil, nil
+	}
+
+	var filter func(*http.Request) bool
+	if cfg.harEntities != "" {
+		entities := make(map[string]bool)
+		for _, e := range strings.Split(cfg.harEntities, ",") {
+			entities[e] = true
+		}
+		filter = func(req *http.Request) bool {
+			u, err := malscraper.ParseURL(req.URL.String())
+			return err == nil && entities[u.Entity]
+		}
+	}
+
+	r, err := har.NewRecorderWithConfig(har.Config{
+		Dir:            cfg.harDir,
+		Client:         client,
+		MaxBodySize:    cfg.harMaxBody,
+		RotateInterval: cfg.harRotate,
+		Filter:         filter,
+		Logger:         logger,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, r, nil
+}
+
+// newPageCacher to create file cache for raw MyAnimeList pages.
+// Returns nil if page cache directory is not set.
+func newPageCacher(cfg config) (service.Cacher, error) {
+	if cfg.pageCacheDir == "" {
+		return nil, nil
+	}
+	return filecache.NewWithConfig(filecache.Config{
+		Dir:  cfg.pageCacheDir,
+		TTL:  cfg.pageCacheTime,
+		Gzip: true,
+	})
+}
+
+func newMalscraper(cfg config, client service.HTTPClient, pageCacher service.Cacher) (*malscraper.Malscraper, error) {
+	mCfg := malscraper.Config{
+		CacheTime:               cfg.cacheTime,
+		SearchCacheTime:         cfg.searchCacheTime,
+		StaleCacheTime:          cfg.staleCacheTime,
+		ConditionalRequest:      cfg.conditional,
+		CircuitBreakerThreshold: cfg.breakerThresh,
+		CircuitBreakerTimeout:   cfg.breakerTimeout,
+		CleanImageURL:           cfg.cleanImage,
+		CleanVideoURL:           cfg.cleanVideo,
+		LegacyEnumJSON:          cfg.legacyEnum,
+		LoadReference:           cfg.loadReference,
+		LogLevel:                cfg.logLevel,
+		LogColor:                cfg.logColor,
+		HTTPClient:              client,
+		DryRun:                  cfg.dryRun,
+	}
+
+	if pageCacher != nil {
+		mCfg.PageCacher = pageCacher
+		mCfg.PageCacheTime = cfg.pageCacheTime
+	}
+
+	switch cfg.cache {
+	case "memory":
+	case "file":
+		c, err := filecache.NewWithConfig(filecache.Config{
+			Dir:     cfg.cacheDir,
+			TTL:     getFileCacheTTL(cfg),
+			Gzip:    true,
+			MaxSize: cfg.cacheMaxSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mCfg.Cacher = c
+	case "none":
+		c, err := nocache.New()
+		if err != nil {
+			return nil, err
+		}
+		mCfg.Cacher = c
+	default:
+		return nil, fmt.Errorf("invalid cache type %q", cfg.cache)
+	}
+
+	m, err := malscraper.New(mCfg)
+	if err != nil {
+		if mCfg.Cacher != nil {
+			mCfg.Cacher.Close()
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+// getFileCacheTTL to get file cache expired time which also
+// keeps expired data for stale cache and conditional request.
+func getFileCacheTTL(cfg config) time.Duration {
+	stale := cfg.staleCacheTime
+	if cfg.conditional && stale <= 0 {
+		// Same as malscraper default.
+		stale = cfg.cacheTime
+	}
+	return cfg.cacheTime + stale
+}
+
+func main() {
+	cfg := parseFlags()
+	logger := mallogger.New(cfg.logLevel, cfg.logColor)
+
+	if err := run(cfg, logger); err != nil {
+		logger.Fatal(err.Error())
+		os.Exit(1)
+	}
+}
+
+// run to start the server until stop signal or server error.
+// Returns error instead of exiting so opened cache and HAR
+// file are closed.
+func run(cfg config, logger service.Logger) error {
+	client, recorder, err := newHTTPClient(cfg, logger)
+	if err != nil {
+		return fmt.Errorf("failed initiating http client: %w", err)
+	}
+	if recorder != nil {
+		defer recorder.Close()
+	}
+
+	pageCacher, err := newPageCacher(cfg)
+	if err != nil {
+		return fmt.Errorf("failed initiating page cache: %w", err)
+	}
+	if pageCacher != nil {
+		defer pageCacher.Close()
+	}
+
+	m, err := newMalscraper(cfg, client, pageCacher)
+	if err != nil {
+		return fmt.Errorf("failed initiating malscraper: %w", err)
+	}
+	defer m.Close()
+
+	s, err := newServer(m, logger, cfg.adminToken)
+	if err != nil {
+		return fmt.Errorf("failed initiating server: %w", err)
+	}
+
+	srv := &http.Server{
+		Addr:    cfg.addr,
+		Handler: s.handler(),
+	}
+
+	// Run server.
+	errChan := make(chan error, 1)
+	go func() {
+		logger.Info("server listening at %s", cfg.addr)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errChan <- err
+		}
+	}()
+
+	if cfg.loadReference {
+		if err := m.Warmup(context.Background()); err != nil {
+			logger.Error("failed loading reference data: %s", err.Error())
+		}
+	}
+	s.setReady(true)
+
+	// Wait for stop signal or server error.
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+
+	var srvErr error
+	select {
+	case srvErr = <-errChan:
+		srvErr = fmt.Errorf("server error: %w", srvErr)
+	case sig := <-sigChan:
+		logger.Info("received %s, shutting down", sig.String())
+	}
+
+	// Graceful shutdown.
+	s.setReady(false)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Error("failed shutting down server: %s", err.Error())
+	}
+
+	return srvErr
+}
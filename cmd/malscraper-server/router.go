@@ -0,0 +1,209 @@
+package main
+
+import (
+	e "errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	malscraper "github.com/rl404/go-malscraper"
+	"github.com/rl404/go-malscraper/errors"
+)
+
+// handlerFunc is route handler which returns the same values
+// as malscraper methods (data, HTTP status code, and error).
+type handlerFunc func(p *params) (interface{}, int, error)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// router is a simple path router. Path segment wrapped with
+// curly braces (`{id}`) will be matched with any value and
+// can be retrieved from handler's params.
+type router struct {
+	routes []route
+}
+
+// handle to add GET route.
+func (rt *router) handle(pattern string, h handlerFunc) {
+	rt.handleMethod(http.MethodGet, pattern, h)
+}
+
+func (rt *router) handleMethod(method, pattern string, h handlerFunc) {
+	rt.routes = append(rt.routes, route{
+		method:   method,
+		segments: splitPath(pattern),
+		handler:  h,
+	})
+}
+
+// match to get the first route matching the method and path.
+// Returns `errMethod` if the path only matches other methods.
+func (rt *router) match(method, path string) (handlerFunc, map[string]string, error) {
+	var err error
+	segments := splitPath(path)
+	for _, r := range rt.routes {
+		if len(r.segments) != len(segments) {
+			continue
+		}
+
+		vars := make(map[string]string)
+		matched := true
+		for i, s := range r.segments {
+			if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+				vars[s[1:len(s)-1]] = segments[i]
+				continue
+			}
+			if s != segments[i] {
+				matched = false
+				break
+			}
+		}
+
+		if !matched {
+			continue
+		}
+
+		if r.method != method {
+			err = errMethod
+			continue
+		}
+
+		return r.handler, vars, nil
+	}
+	return nil, nil, err
+}
+
+func splitPath(path string) []string {
+	var segments []string
+	for _, s := range strings.Split(path, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
+// params contains request's path variables and query params.
+// Failed conversion is saved to `err` so handler can check it
+// once after getting all the params.
+type params struct {
+	r     *http.Request
+	vars  map[string]string
+	query url.Values
+	err   error
+}
+
+func (p *params) setErr(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+// id to get path variable as positive int.
+func (p *params) id(name string) int {
+	id, err := strconv.Atoi(p.vars[name])
+	if err != nil || id <= 0 {
+		p.setErr(errors.ErrInvalidID)
+	}
+	return id
+}
+
+func (p *params) str(name string) string {
+	return p.vars[name]
+}
+
+// page to get `page` query param. Default is 1.
+func (p *params) page() int {
+	page := p.queryInt("page", 1)
+	if page <= 0 {
+		p.setErr(errors.ErrInvalidPage)
+	}
+	return page
+}
+
+// mainType to get `type` query param, `anime` or `manga`.
+// Default is anime.
+func (p *params) mainType() int {
+	switch p.queryStr("type") {
+	case "", "anime":
+		return malscraper.AnimeType
+	case "manga":
+		return malscraper.MangaType
+	default:
+		p.setErr(&paramError{name: "type"})
+		return 0
+	}
+}
+
+func (p *params) queryStr(name string) string {
+	return strings.TrimSpace(p.query.Get(name))
+}
+
+func (p *params) queryInt(name string, def int) int {
+	str := p.queryStr(name)
+	if str == "" {
+		return def
+	}
+	v, err := strconv.Atoi(str)
+	if err != nil {
+		p.setErr(&paramError{name: name})
+	}
+	return v
+}
+
+func (p *params) queryFloat(name string, def float64) float64 {
+	str := p.queryStr(name)
+	if str == "" {
+		return def
+	}
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		p.setErr(&paramError{name: name})
+	}
+	return v
+}
+
+func (p *params) queryBool(name string) bool {
+	str := p.queryStr(name)
+	if str == "" {
+		return false
+	}
+	v, err := strconv.ParseBool(str)
+	if err != nil {
+		p.setErr(&paramError{name: name})
+	}
+	return v
+}
+
+func (p *params) queryInts(name string) []int {
+	var ints []int
+	for _, str := range strings.Split(p.queryStr(name), ",") {
+		if str = strings.TrimSpace(str); str == "" {
+			continue
+		}
+		v, err := strconv.Atoi(str)
+		if err != nil {
+			p.setErr(&paramError{name: name})
+		}
+		ints = append(ints, v)
+	}
+	return ints
+}
+
+// errMethod is returned by router if the path exists
+// but not for the request method.
+var errMethod = e.New(http.StatusText(http.StatusMethodNotAllowed))
+
+// paramError is returned if query param has invalid format.
+type paramError struct {
+	name string
+}
+
+func (e *paramError) Error() string {
+	return "invalid query param " + e.name
+}
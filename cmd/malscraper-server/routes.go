@@ -0,0 +1,455 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	malscraper "github.com/rl404/go-malscraper"
+	"github.com/rl404/go-malscraper/model"
+)
+
+func (s *server) registerRoutes() {
+	m, rt := s.mal, &s.router
+
+	// Anime.
+	rt.handle("/anime/{id}", byID(func(id int) (interface{}, int, error) { return m.GetAnime(id) }))
+	rt.handle("/anime/{id}/characters", byID(func(id int) (interface{}, int, error) { return m.GetAnimeCharacter(id) }))
+	rt.handle("/anime/{id}/staff", byID(func(id int) (interface{}, int, error) { return m.GetAnimeStaff(id) }))
+	rt.handle("/anime/{id}/videos", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetAnimeVideo(id, page) }))
+	rt.handle("/anime/{id}/episodes", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetAnimeEpisode(id, page) }))
+	rt.handle("/anime/{id}/stats", byID(func(id int) (interface{}, int, error) { return m.GetAnimeStats(id) }))
+	rt.handle("/anime/{id}/reviews", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetAnimeReview(id, page) }))
+	rt.handle("/anime/{id}/recommendations", byID(func(id int) (interface{}, int, error) { return m.GetAnimeRecommendation(id) }))
+	rt.handle("/anime/{id}/news", byID(func(id int) (interface{}, int, error) { return m.GetAnimeNews(id) }))
+	rt.handle("/anime/{id}/articles", byID(func(id int) (interface{}, int, error) { return m.GetAnimeArticle(id) }))
+	rt.handle("/anime/{id}/clubs", byID(func(id int) (interface{}, int, error) { return m.GetAnimeClub(id) }))
+	rt.handle("/anime/{id}/pictures", byID(func(id int) (interface{}, int, error) { return m.GetAnimePicture(id) }))
+	rt.handle("/anime/{id}/more-info", byID(func(id int) (interface{}, int, error) { return m.GetAnimeMoreInfo(id) }))
+
+	// Manga.
+	rt.handle("/manga/{id}", byID(func(id int) (interface{}, int, error) { return m.GetManga(id) }))
+	rt.handle("/manga/{id}/characters", byID(func(id int) (interface{}, int, error) { return m.GetMangaCharacter(id) }))
+	rt.handle("/manga/{id}/stats", byID(func(id int) (interface{}, int, error) { return m.GetMangaStats(id) }))
+	rt.handle("/manga/{id}/reviews", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetMangaReview(id, page) }))
+	rt.handle("/manga/{id}/recommendations", byID(func(id int) (interface{}, int, error) { return m.GetMangaRecommendation(id) }))
+	rt.handle("/manga/{id}/news", byID(func(id int) (interface{}, int, error) { return m.GetMangaNews(id) }))
+	rt.handle("/manga/{id}/articles", byID(func(id int) (interface{}, int, error) { return m.GetMangaArticle(id) }))
+	rt.handle("/manga/{id}/clubs", byID(func(id int) (interface{}, int, error) { return m.GetMangaClub(id) }))
+	rt.handle("/manga/{id}/pictures", byID(func(id int) (interface{}, int, error) { return m.GetMangaPicture(id) }))
+	rt.handle("/manga/{id}/more-info", byID(func(id int) (interface{}, int, error) { return m.GetMangaMoreInfo(id) }))
+
+	// Character.
+	rt.handle("/character/{id}", byID(func(id int) (interface{}, int, error) { return m.GetCharacter(id) }))
+	rt.handle("/character/{id}/anime", byID(func(id int) (interface{}, int, error) { return m.GetCharacterAnime(id) }))
+	rt.handle("/character/{id}/manga", byID(func(id int) (interface{}, int, error) { return m.GetCharacterManga(id) }))
+	rt.handle("/character/{id}/articles", byID(func(id int) (interface{}, int, error) { return m.GetCharacterArticle(id) }))
+	rt.handle("/character/{id}/pictures", byID(func(id int) (interface{}, int, error) { return m.GetCharacterPicture(id) }))
+	rt.handle("/character/{id}/clubs", byID(func(id int) (interface{}, int, error) { return m.GetCharacterClub(id) }))
+	rt.handle("/character/{id}/voice-actors", byID(func(id int) (interface{}, int, error) { return m.GetCharacterVA(id) }))
+	rt.handle("/character/{id}/ography", s.getCharacterOgraphy)
+
+	// People.
+	rt.handle("/people/{id}", byID(func(id int) (interface{}, int, error) { return m.GetPeople(id) }))
+	rt.handle("/people/{id}/characters", byID(func(id int) (interface{}, int, error) { return m.GetPeopleCharacter(id) }))
+	rt.handle("/people/{id}/staff", byID(func(id int) (interface{}, int, error) { return m.GetPeopleStaff(id) }))
+	rt.handle("/people/{id}/manga", byID(func(id int) (interface{}, int, error) { return m.GetPeopleManga(id) }))
+	rt.handle("/people/{id}/news", byID(func(id int) (interface{}, int, error) { return m.GetPeopleNews(id) }))
+	rt.handle("/people/{id}/articles", byID(func(id int) (interface{}, int, error) { return m.GetPeopleArticle(id) }))
+	rt.handle("/people/{id}/pictures", byID(func(id int) (interface{}, int, error) { return m.GetPeoplePicture(id) }))
+
+	// Producer & magazine.
+	rt.handle("/producers", func(p *params) (interface{}, int, error) { return m.GetProducers() })
+	rt.handle("/producer/{id}", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetProducer(id, page) }))
+	rt.handle("/magazines", func(p *params) (interface{}, int, error) { return m.GetMagazines() })
+	rt.handle("/magazine/{id}", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetMagazine(id, page) }))
+
+	// Genre.
+	rt.handle("/genres/anime", func(p *params) (interface{}, int, error) { return m.GetAnimeGenres() })
+	rt.handle("/genres/manga", func(p *params) (interface{}, int, error) { return m.GetMangaGenres() })
+	rt.handle("/genre/anime/{id}", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetAnimeWithGenre(id, page) }))
+	rt.handle("/genre/manga/{id}", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetMangaWithGenre(id, page) }))
+
+	// Review.
+	rt.handle("/review/{id}", byID(func(id int) (interface{}, int, error) { return m.GetReview(id) }))
+	rt.handle("/reviews/anime", byPage(func(page int) (interface{}, int, error) { return m.GetAnimeReviews(page) }))
+	rt.handle("/reviews/manga", byPage(func(page int) (interface{}, int, error) { return m.GetMangaReviews(page) }))
+	rt.handle("/reviews/best", byPage(func(page int) (interface{}, int, error) { return m.GetBestReviews(page) }))
+
+	// Recommendation.
+	rt.handle("/recommendation/anime/{id1}/{id2}", s.getRecommendation(malscraper.AnimeType))
+	rt.handle("/recommendation/manga/{id1}/{id2}", s.getRecommendation(malscraper.MangaType))
+	rt.handle("/recommendations/anime", byPage(func(page int) (interface{}, int, error) { return m.GetAnimeRecommendations(page) }))
+	rt.handle("/recommendations/manga", byPage(func(page int) (interface{}, int, error) { return m.GetMangaRecommendations(page) }))
+
+	// Season.
+	rt.handle("/season", s.getSeason)
+
+	// News.
+	rt.handle("/news", s.getNewsList)
+	rt.handle("/news/tags", func(p *params) (interface{}, int, error) { return m.GetNewsTag() })
+	rt.handle("/news/{id}", byID(func(id int) (interface{}, int, error) { return m.GetNews(id) }))
+
+	// Article.
+	rt.handle("/articles", s.getArticles)
+	rt.handle("/articles/tags", func(p *params) (interface{}, int, error) { return m.GetArticleTag() })
+	rt.handle("/article/{id}", byID(func(id int) (interface{}, int, error) { return m.GetArticle(id) }))
+
+	// Club.
+	rt.handle("/clubs", byPage(func(page int) (interface{}, int, error) { return m.GetClubs(page) }))
+	rt.handle("/club/{id}", byID(func(id int) (interface{}, int, error) { return m.GetClub(id) }))
+	rt.handle("/club/{id}/members", byIDPage(func(id, page int) (interface{}, int, error) { return m.GetClubMember(id, page) }))
+	rt.handle("/club/{id}/pictures", byID(func(id int) (interface{}, int, error) { return m.GetClubPicture(id) }))
+	rt.handle("/club/{id}/related", byID(func(id int) (interface{}, int, error) { return m.GetClubRelated(id) }))
+
+	// Top list.
+	rt.handle("/top/anime", s.getTopAnime)
+	rt.handle("/top/manga", s.getTopManga)
+	rt.handle("/top/characters", byPage(func(page int) (interface{}, int, error) { return m.GetTopCharacter(page) }))
+	rt.handle("/top/people", byPage(func(page int) (interface{}, int, error) { return m.GetTopPeople(page) }))
+
+	// User.
+	rt.handle("/user/{name}", byName(func(name string) (interface{}, int, error) { return m.GetUser(name) }))
+	rt.handle("/user/{name}/stats", byName(func(name string) (interface{}, int, error) { return m.GetUserStats(name) }))
+	rt.handle("/user/{name}/favorites", byName(func(name string) (interface{}, int, error) { return m.GetUserFavorite(name) }))
+	rt.handle("/user/{name}/friends", byNamePage(func(name string, page int) (interface{}, int, error) { return m.GetUserFriend(name, page) }))
+	rt.handle("/user/{name}/history", s.getUserHistory)
+	rt.handle("/user/{name}/reviews", byNamePage(func(name string, page int) (interface{}, int, error) { return m.GetUserReview(name, page) }))
+	rt.handle("/user/{name}/recommendations", byNamePage(func(name string, page int) (interface{}, int, error) { return m.GetUserRecommendation(name, page) }))
+	rt.handle("/user/{name}/clubs", byName(func(name string) (interface{}, int, error) { return m.GetUserClub(name) }))
+	rt.handle("/user/{name}/animelist", s.getUserAnime)
+	rt.handle("/user/{name}/mangalist", s.getUserManga)
+	rt.handle("/user/{name}/breakdown", s.getUserAnimeBreakdown)
+	rt.handle("/user/{name}/compare/anime/{other}", s.compareUser(malscraper.AnimeType))
+	rt.handle("/user/{name}/compare/manga/{other}", s.compareUser(malscraper.MangaType))
+
+	// Search.
+	rt.handle("/search/anime", s.searchAnime)
+	rt.handle("/search/manga", s.searchManga)
+	rt.handle("/search/character", s.searchCharacter)
+	rt.handle("/search/people", s.searchPeople)
+	rt.handle("/search/club", s.searchClub)
+	rt.handle("/search/user", s.searchUser)
+
+	// Resolver.
+	rt.handle("/resolve/anime", s.resolve(malscraper.AnimeType))
+	rt.handle("/resolve/manga", s.resolve(malscraper.MangaType))
+	rt.handle("/url", func(p *params) (interface{}, int, error) { return m.Dispatch(p.r.Context(), p.queryStr("u")) })
+}
+
+// byID to wrap handler which needs `{id}` path variable.
+func byID(fn func(id int) (interface{}, int, error)) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		id := p.id("id")
+		if p.err != nil {
+			return nil, http.StatusBadRequest, p.err
+		}
+		return fn(id)
+	}
+}
+
+// byIDPage to wrap handler which needs `{id}` path variable
+// and `page` query param.
+func byIDPage(fn func(id, page int) (interface{}, int, error)) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		id, page := p.id("id"), p.page()
+		if p.err != nil {
+			return nil, http.StatusBadRequest, p.err
+		}
+		return fn(id, page)
+	}
+}
+
+// byPage to wrap handler which needs `page` query param.
+func byPage(fn func(page int) (interface{}, int, error)) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		page := p.page()
+		if p.err != nil {
+			return nil, http.StatusBadRequest, p.err
+		}
+		return fn(page)
+	}
+}
+
+// byName to wrap handler which needs `{name}` path variable.
+func byName(fn func(name string) (interface{}, int, error)) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		return fn(p.str("name"))
+	}
+}
+
+// byNamePage to wrap handler which needs `{name}` path variable
+// and `page` query param.
+func byNamePage(fn func(name string, page int) (interface{}, int, error)) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		name, page := p.str("name"), p.page()
+		if p.err != nil {
+			return nil, http.StatusBadRequest, p.err
+		}
+		return fn(name, page)
+	}
+}
+
+func (s *server) getRecommendation(_type int) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		id1, id2 := p.id("id1"), p.id("id2")
+		if p.err != nil {
+			return nil, http.StatusBadRequest, p.err
+		}
+		return s.mal.GetRecommendation(_type, id1, id2)
+	}
+}
+
+func (s *server) getCharacterOgraphy(p *params) (interface{}, int, error) {
+	id, _type := p.id("id"), p.mainType()
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetCharacterOgraphy(_type, id)
+}
+
+func (s *server) getSeason(p *params) (interface{}, int, error) {
+	var seasonYear []interface{}
+	if season := p.queryStr("season"); season != "" {
+		seasonYear = append(seasonYear, season, p.queryInt("year", time.Now().Year()))
+	}
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetSeason(seasonYear...)
+}
+
+func (s *server) getNewsList(p *params) (interface{}, int, error) {
+	page, tag := p.page(), p.queryStr("tag")
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetNewsList(page, tag)
+}
+
+func (s *server) getArticles(p *params) (interface{}, int, error) {
+	page, tag := p.page(), p.queryStr("tag")
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetArticles(page, tag)
+}
+
+func (s *server) getTopAnime(p *params) (interface{}, int, error) {
+	_type, page := p.queryInt("type", malscraper.TopDefault), p.page()
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetTopAnime(_type, page)
+}
+
+func (s *server) getTopManga(p *params) (interface{}, int, error) {
+	_type, page := p.queryInt("type", malscraper.TopDefault), p.page()
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetTopManga(_type, page)
+}
+
+func (s *server) getUserHistory(p *params) (interface{}, int, error) {
+	_type := malscraper.AllType
+	switch p.queryStr("type") {
+	case "anime":
+		_type = malscraper.AnimeType
+	case "manga":
+		_type = malscraper.MangaType
+	}
+	return s.mal.GetUserHistory(p.str("name"), _type)
+}
+
+func (s *server) getUserListQuery(p *params) model.UserListQuery {
+	return model.UserListQuery{
+		Username: p.str("name"),
+		Page:     p.queryInt("page", 1),
+		Status:   p.queryInt("status", malscraper.StatusAll),
+		Order:    p.queryInt("order", malscraper.OrderDefault),
+		Tag:      p.queryStr("tag"),
+	}
+}
+
+func (s *server) getUserAnime(p *params) (interface{}, int, error) {
+	q := s.getUserListQuery(p)
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetUserAnimeAdv(q)
+}
+
+func (s *server) getUserManga(p *params) (interface{}, int, error) {
+	q := s.getUserListQuery(p)
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetUserMangaAdv(q)
+}
+
+func (s *server) getUserAnimeBreakdown(p *params) (interface{}, int, error) {
+	var limit []int
+	if p.queryStr("limit") != "" {
+		limit = append(limit, p.queryInt("limit", 0))
+	}
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.GetUserAnimeBreakdown(p.str("name"), limit...)
+}
+
+func (s *server) compareUser(_type int) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		if _type == malscraper.MangaType {
+			return s.mal.CompareUserManga(p.str("name"), p.str("other"))
+		}
+		return s.mal.CompareUserAnime(p.str("name"), p.str("other"))
+	}
+}
+
+func (s *server) getQuery(p *params) model.Query {
+	q := model.Query{
+		Title:        p.queryStr("q"),
+		Page:         p.queryInt("page", 1),
+		Type:         p.queryInt("type", 0),
+		Score:        p.queryInt("score", 0),
+		Status:       p.queryInt("status", 0),
+		ProducerID:   p.queryInt("producer", 0),
+		MagazineID:   p.queryInt("magazine", 0),
+		Rating:       p.queryInt("rating", 0),
+		ExcludeGenre: p.queryBool("exclude"),
+		GenreIDs:     p.queryInts("genre"),
+		FirstLetter:  p.queryStr("letter"),
+	}
+	q.StartDate = s.getDate(p, "start")
+	q.EndDate = s.getDate(p, "end")
+	return q
+}
+
+// getDate to get date query param with format `2006-01-02`.
+func (s *server) getDate(p *params, name string) time.Time {
+	str := p.queryStr(name)
+	if str == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse("2006-01-02", str)
+	if err != nil {
+		p.setErr(&paramError{name: name})
+	}
+	return t
+}
+
+func (s *server) searchAnime(p *params) (interface{}, int, error) {
+	q := s.getQuery(p)
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.AdvSearchAnime(q)
+}
+
+func (s *server) searchManga(p *params) (interface{}, int, error) {
+	q := s.getQuery(p)
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.AdvSearchManga(q)
+}
+
+func (s *server) searchCharacter(p *params) (interface{}, int, error) {
+	name, page := p.queryStr("q"), p.page()
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.SearchCharacter(name, page)
+}
+
+func (s *server) searchPeople(p *params) (interface{}, int, error) {
+	name, page := p.queryStr("q"), p.page()
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.SearchPeople(name, page)
+}
+
+func (s *server) searchClub(p *params) (interface{}, int, error) {
+	q := model.ClubQuery{
+		Name:     p.queryStr("q"),
+		Page:     p.page(),
+		Category: p.queryInt("category", malscraper.AllCategory),
+		Sort:     p.queryInt("sort", malscraper.SortDefault),
+	}
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.AdvSearchClub(q)
+}
+
+func (s *server) searchUser(p *params) (interface{}, int, error) {
+	q := model.UserQuery{
+		Username: p.queryStr("q"),
+		Page:     p.page(),
+		Location: p.queryStr("location"),
+		MinAge:   p.queryInt("min_age", 0),
+		MaxAge:   p.queryInt("max_age", 0),
+		Gender:   p.queryInt("gender", malscraper.GenderDefault),
+	}
+	if p.err != nil {
+		return nil, http.StatusBadRequest, p.err
+	}
+	return s.mal.AdvSearchUser(q)
+}
+
+func (s *server) resolve(_type int) handlerFunc {
+	return func(p *params) (interface{}, int, error) {
+		title := p.queryStr("title")
+		hint := model.ResolveHint{
+			Year:          p.queryInt("year", 0),
+			Type:          p.queryInt("type", 0),
+			Episode:       p.queryInt("episode", 0),
+			MinConfidence: p.queryFloat("min_confidence", 0),
+		}
+		if p.err != nil {
+			return nil, http.StatusBadRequest, p.err
+		}
+		if _type == malscraper.MangaType {
+			return s.mal.ResolveManga(p.r.Context(), title, hint)
+		}
+		return s.mal.ResolveAnime(p.r.Context(), title, hint)
+	}
+}
+
+// seasonSchedule to write seasonal anime schedule as iCalendar feed
+// instead of JSON. Time zone can be set using `tz` query param
+// (e.g. `Asia/Jakarta`). Default is UTC.
+func (s *server) seasonSchedule(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r, query: r.URL.Query()}
+
+	var seasonYear []interface{}
+	if season := p.queryStr("season"); season != "" {
+		seasonYear = append(seasonYear, strings.ToLower(season), p.queryInt("year", time.Now().Year()))
+	}
+	if p.err != nil {
+		s.writeError(w, http.StatusBadRequest, p.err)
+		return
+	}
+
+	loc, err := time.LoadLocation(p.queryStr("tz"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, &paramError{name: "tz"})
+		return
+	}
+
+	// Buffer the feed so error can still be returned as JSON.
+	var buf strings.Builder
+	if code, err := s.mal.GetSeasonSchedule(&buf, loc, seasonYear...); err != nil {
+		s.writeError(w, code, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(buf.String())); err != nil {
+		s.logger.Error("failed writing response: %s", err.Error())
+	}
+}
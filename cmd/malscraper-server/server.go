@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	malscraper "github.com/rl404/go-malscraper"
+	"github.com/rl404/go-malscraper/service"
+)
+
+type server struct {
+	mal        *malscraper.Malscraper
+	logger     service.Logger
+	router     router
+	graphql    http.Handler
+	adminToken string
+	ready      int32
+}
+
+// response is JSON response format for all routes.
+type response struct {
+	Status  int         `json:"status"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data"`
+}
+
+// newServer to create new server. Admin routes (plan and cache
+// maintenance) are only registered if `adminToken` is not empty.
+func newServer(mal *malscraper.Malscraper, logger service.Logger, adminToken string) (*server, error) {
+	g, err := mal.GraphQL()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &server{mal: mal, logger: logger, graphql: g, adminToken: adminToken}
+	s.registerRoutes()
+	if adminToken != "" {
+		s.registerAdminRoutes()
+	}
+	return s, nil
+}
+
+// handler to get HTTP handler for all routes including
+// health and readiness check.
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.health)
+	mux.HandleFunc("/readyz", s.readiness)
+	mux.HandleFunc("/season/schedule", s.seasonSchedule)
+	mux.Handle("/graphql", s.graphql)
+	mux.HandleFunc("/", s.serveAPI)
+	return mux
+}
+
+func (s *server) setReady(ready bool) {
+	var v int32
+	if ready {
+		v = 1
+	}
+	atomic.StoreInt32(&s.ready, v)
+}
+
+func (s *server) isReady() bool {
+	return atomic.LoadInt32(&s.ready) == 1
+}
+
+// health is liveness check. Always ok as long as the server runs.
+func (s *server) health(w http.ResponseWriter, r *http.Request) {
+	s.writeJSON(w, http.StatusOK, response{
+		Status:  http.StatusOK,
+		Message: http.StatusText(http.StatusOK),
+	})
+}
+
+// readiness is readiness check. Not ready when the server
+// is starting or shutting down.
+func (s *server) readiness(w http.ResponseWriter, r *http.Request) {
+	code := http.StatusOK
+	if !s.isReady() {
+		code = http.StatusServiceUnavailable
+	}
+	s.writeJSON(w, code, response{
+		Status:  code,
+		Message: http.StatusText(code),
+	})
+}
+
+func (s *server) serveAPI(w http.ResponseWriter, r *http.Request) {
+	t := time.Now()
+
+	h, vars, err := s.router.match(r.Method, r.URL.Path)
+	if err == errMethod {
+		s.writeError(w, http.StatusMethodNotAllowed, nil)
+		return
+	}
+	if h == nil {
+		s.writeError(w, http.StatusNotFound, nil)
+		return
+	}
+
+	data, code, err := h(&params{r: r, vars: vars, query: r.URL.Query()})
+	s.logger.Debug("%s %v (%s)", r.URL.String(), code, time.Since(t).Truncate(time.Microsecond))
+	if err != nil {
+		s.writeError(w, code, err)
+		return
+	}
+
+	s.writeJSON(w, code, response{
+		Status:  code,
+		Message: http.StatusText(code),
+		Data:    s.mal.JSONValue(data),
+	})
+}
+
+func (s *server) writeError(w http.ResponseWriter, code int, err error) {
+	if code == 0 {
+		code = http.StatusInternalServerError
+	}
+
+	msg := http.StatusText(code)
+	if err != nil {
+		msg = err.Error()
+	}
+
+	s.writeJSON(w, code, response{
+		Status:  code,
+		Message: msg,
+	})
+}
+
+func (s *server) writeJSON(w http.ResponseWriter, code int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		s.logger.Error("failed encoding response: %s", err.Error())
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	malscraper "github.com/rl404/go-malscraper"
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *server {
+	m, err := malscraper.NewNoCache()
+	require.NoError(t, err)
+	s, err := newServer(m, mallogger.New(malscraper.LevelZero, false), "")
+	require.NoError(t, err)
+	return s
+}
+
+func doRequest(s *server, method, url string) (int, response) {
+	w := httptest.NewRecorder()
+	s.handler().ServeHTTP(w, httptest.NewRequest(method, url, nil))
+
+	var resp response
+	json.NewDecoder(w.Body).Decode(&resp)
+	return w.Code, resp
+}
+
+func TestHealth(t *testing.T) {
+	s := newTestServer(t)
+	code, resp := doRequest(s, http.MethodGet, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, http.StatusOK, resp.Status)
+}
+
+func TestReadiness(t *testing.T) {
+	s := newTestServer(t)
+
+	t.Run("not-ready", func(t *testing.T) {
+		code, _ := doRequest(s, http.MethodGet, "/readyz")
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+	})
+
+	t.Run("ready", func(t *testing.T) {
+		s.setReady(true)
+		code, _ := doRequest(s, http.MethodGet, "/readyz")
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
+
+func TestServeAPI(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		method  string
+		url     string
+		code    int
+		message string
+	}{
+		{name: "not-found", method: http.MethodGet, url: "/unknown", code: http.StatusNotFound, message: http.StatusText(http.StatusNotFound)},
+		{name: "method", method: http.MethodPost, url: "/anime/1", code: http.StatusMethodNotAllowed, message: http.StatusText(http.StatusMethodNotAllowed)},
+		{name: "invalid-id", method: http.MethodGet, url: "/anime/abc", code: http.StatusBadRequest, message: errors.ErrInvalidID.Error()},
+		{name: "invalid-page", method: http.MethodGet, url: "/anime/1/reviews?page=0", code: http.StatusBadRequest, message: errors.ErrInvalidPage.Error()},
+		{name: "invalid-param", method: http.MethodGet, url: "/top/anime?type=tv", code: http.StatusBadRequest, message: "invalid query param type"},
+		{name: "validator", method: http.MethodGet, url: "/search/anime?q=na", code: http.StatusBadRequest, message: errors.Err3LettersSearch.Error()},
+		{name: "validator-2", method: http.MethodGet, url: "/user/rl404/animelist?status=99", code: http.StatusBadRequest, message: errors.ErrInvalidStatus.Error()},
+		{name: "ography", method: http.MethodGet, url: "/character/1/ography?type=tv", code: http.StatusBadRequest, message: "invalid query param type"},
+		{name: "url", method: http.MethodGet, url: "/url?u=https://example.com", code: http.StatusBadRequest, message: errors.ErrInvalidURL.Error()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			code, resp := doRequest(s, test.method, test.url)
+			assert.Equal(t, test.code, code)
+			assert.Equal(t, test.code, resp.Status)
+			assert.Equal(t, test.message, resp.Message)
+		})
+	}
+}
+
+func TestRouter(t *testing.T) {
+	var rt router
+	rt.handle("/news/tags", nil)
+	rt.handle("/news/{id}", func(p *params) (interface{}, int, error) { return p.id("id"), http.StatusOK, p.err })
+
+	h, vars, err := rt.match(http.MethodGet, "/news/123/")
+	require.NoError(t, err)
+	require.NotNil(t, h)
+	assert.Equal(t, map[string]string{"id": "123"}, vars)
+
+	data, code, err := h(&params{vars: vars})
+	assert.Equal(t, 123, data)
+	assert.Equal(t, http.StatusOK, code)
+	assert.NoError(t, err)
+
+	_, vars, _ = rt.match(http.MethodGet, "/news/tags")
+	assert.Empty(t, vars)
+
+	h, _, err = rt.match(http.MethodGet, "/news/1/2")
+	assert.Nil(t, h)
+	assert.NoError(t, err)
+
+	h, _, err = rt.match(http.MethodPost, "/news/1")
+	assert.Nil(t, h)
+	assert.Equal(t, errMethod, err)
+}
+
+func TestAdmin(t *testing.T) {
+	m, err := malscraper.New(malscraper.Config{DryRun: true})
+	require.NoError(t, err)
+	s, err := newServer(m, mallogger.New(malscraper.LevelZero, false), "secret")
+	require.NoError(t, err)
+
+	doAdmin := func(method, url, token string) (int, response) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(method, url, nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		s.handler().ServeHTTP(w, r)
+
+		var resp response
+		json.NewDecoder(w.Body).Decode(&resp)
+		return w.Code, resp
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		token  string
+		code   int
+	}{
+		{name: "no-token", method: http.MethodGet, url: "/admin/plan", code: http.StatusUnauthorized},
+		{name: "wrong-token", method: http.MethodGet, url: "/admin/plan", token: "wrong", code: http.StatusUnauthorized},
+		{name: "plan", method: http.MethodGet, url: "/admin/plan", token: "secret", code: http.StatusOK},
+		{name: "reset-plan", method: http.MethodDelete, url: "/admin/plan", token: "secret", code: http.StatusOK},
+		{name: "negative", method: http.MethodGet, url: "/admin/cache/negative?entity=anime", token: "secret", code: http.StatusOK},
+		{name: "purge", method: http.MethodDelete, url: "/admin/cache/negative", token: "secret", code: http.StatusOK},
+		{name: "reparse-method", method: http.MethodGet, url: "/admin/cache/reparse?entity=anime", token: "secret", code: http.StatusMethodNotAllowed},
+		{name: "reparse-entity", method: http.MethodPost, url: "/admin/cache/reparse", token: "secret", code: http.StatusBadRequest},
+		{name: "reparse-no-page-cache", method: http.MethodPost, url: "/admin/cache/reparse?entity=anime", token: "secret", code: http.StatusNotImplemented},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			code, resp := doAdmin(test.method, test.url, test.token)
+			assert.Equal(t, test.code, code)
+			assert.Equal(t, test.code, resp.Status)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		code, _ := doRequest(newTestServer(t), http.MethodGet, "/admin/plan")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestGetFileCacheTTL(t *testing.T) {
+	assert.Equal(t, time.Hour, getFileCacheTTL(config{cacheTime: time.Hour}))
+	assert.Equal(t, 3*time.Hour, getFileCacheTTL(config{cacheTime: time.Hour, staleCacheTime: 2 * time.Hour}))
+	assert.Equal(t, 2*time.Hour, getFileCacheTTL(config{cacheTime: time.Hour, conditional: true}))
+	assert.Equal(t, 3*time.Hour, getFileCacheTTL(config{cacheTime: time.Hour, staleCacheTime: 2 * time.Hour, conditional: true}))
+}
@@ -0,0 +1,655 @@
+package main
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	malscraper "github.com/rl404/go-malscraper"
+	"github.com/rl404/go-malscraper/model"
+)
+
+// commandFunc is command handler. Returned data will be written
+// using the chosen output format.
+type commandFunc func(m *malscraper.Malscraper, args []string, o *options, w io.Writer) (interface{}, error)
+
+type command struct {
+	usage string
+	fn    commandFunc
+}
+
+var commands = map[string]command{
+	"anime":           {usage: "anime <id> [--part characters|staff|videos|episodes|stats|reviews|recommendations|news|articles|clubs|pictures|more-info]", fn: animeCmd},
+	"manga":           {usage: "manga <id> [--part characters|stats|reviews|recommendations|news|articles|clubs|pictures|more-info]", fn: mangaCmd},
+	"character":       {usage: "character <id> [--part anime|manga|ography|articles|pictures|clubs|voice-actors] [--type anime|manga]", fn: characterCmd},
+	"people":          {usage: "people <id> [--part characters|staff|manga|news|articles|pictures]", fn: peopleCmd},
+	"producers":       {usage: "producers", fn: producersCmd},
+	"producer":        {usage: "producer <id> [--page]", fn: producerCmd},
+	"magazines":       {usage: "magazines", fn: magazinesCmd},
+	"magazine":        {usage: "magazine <id> [--page]", fn: magazineCmd},
+	"genres":          {usage: "genres [--type anime|manga]", fn: genresCmd},
+	"genre":           {usage: "genre <id> [--type anime|manga] [--page]", fn: genreCmd},
+	"review":          {usage: "review <id>", fn: reviewCmd},
+	"reviews":         {usage: "reviews [--type anime|manga|best] [--page]", fn: reviewsCmd},
+	"recommendation":  {usage: "recommendation <id1> <id2> [--type anime|manga]", fn: recommendationCmd},
+	"recommendations": {usage: "recommendations [--type anime|manga] [--page]", fn: recommendationsCmd},
+	"season":          {usage: "season [season] [year]", fn: seasonCmd},
+	"schedule":        {usage: "schedule [season] [year] [--tz]", fn: scheduleCmd},
+	"news":            {usage: "news [id] [--page] [--tag]", fn: newsCmd},
+	"news-tags":       {usage: "news-tags", fn: newsTagsCmd},
+	"article":         {usage: "article [id] [--page] [--tag]", fn: articleCmd},
+	"article-tags":    {usage: "article-tags", fn: articleTagsCmd},
+	"clubs":           {usage: "clubs [--page]", fn: clubsCmd},
+	"club":            {usage: "club <id> [--part members|pictures|related] [--page]", fn: clubCmd},
+	"top":             {usage: "top <anime|manga|character|people> [--type] [--page]", fn: topCmd},
+	"user":            {usage: "user <username> [--part stats|favorites|friends|history|reviews|recommendations|clubs|animelist|mangalist|breakdown]", fn: userCmd},
+	"compare":         {usage: "compare <username1> <username2> [--type anime|manga]", fn: compareCmd},
+	"search":          {usage: "search <anime|manga|character|people|club|user> <query> [query flags]", fn: searchCmd},
+	"resolve":         {usage: "resolve <anime|manga> <title> [--year] [--type] [--episode] [--min-confidence]", fn: resolveCmd},
+	"url":             {usage: "url <myanimelist url>", fn: urlCmd},
+}
+
+func getArg(args []string, i int, name string) (string, error) {
+	if i >= len(args) {
+		return "", &usageError{msg: "missing argument " + name}
+	}
+	return args[i], nil
+}
+
+func getIntArg(args []string, i int, name string) (int, error) {
+	str, err := getArg(args, i, name)
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, &usageError{msg: name + " should be a number"}
+	}
+	return v, nil
+}
+
+func invalidPart(part string) error {
+	return &usageError{msg: "invalid part " + part}
+}
+
+func animeCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.part {
+	case "":
+		data, _, err = m.GetAnime(id)
+	case "characters":
+		data, _, err = m.GetAnimeCharacter(id)
+	case "staff":
+		data, _, err = m.GetAnimeStaff(id)
+	case "videos":
+		data, _, err = m.GetAnimeVideo(id, o.page)
+	case "episodes":
+		data, _, err = m.GetAnimeEpisode(id, o.page)
+	case "stats":
+		data, _, err = m.GetAnimeStats(id)
+	case "reviews":
+		data, _, err = m.GetAnimeReview(id, o.page)
+	case "recommendations":
+		data, _, err = m.GetAnimeRecommendation(id)
+	case "news":
+		data, _, err = m.GetAnimeNews(id)
+	case "articles":
+		data, _, err = m.GetAnimeArticle(id)
+	case "clubs":
+		data, _, err = m.GetAnimeClub(id)
+	case "pictures":
+		data, _, err = m.GetAnimePicture(id)
+	case "more-info":
+		data, _, err = m.GetAnimeMoreInfo(id)
+	default:
+		return nil, invalidPart(o.part)
+	}
+	return data, err
+}
+
+func mangaCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.part {
+	case "":
+		data, _, err = m.GetManga(id)
+	case "characters":
+		data, _, err = m.GetMangaCharacter(id)
+	case "stats":
+		data, _, err = m.GetMangaStats(id)
+	case "reviews":
+		data, _, err = m.GetMangaReview(id, o.page)
+	case "recommendations":
+		data, _, err = m.GetMangaRecommendation(id)
+	case "news":
+		data, _, err = m.GetMangaNews(id)
+	case "articles":
+		data, _, err = m.GetMangaArticle(id)
+	case "clubs":
+		data, _, err = m.GetMangaClub(id)
+	case "pictures":
+		data, _, err = m.GetMangaPicture(id)
+	case "more-info":
+		data, _, err = m.GetMangaMoreInfo(id)
+	default:
+		return nil, invalidPart(o.part)
+	}
+	return data, err
+}
+
+func characterCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.part {
+	case "":
+		data, _, err = m.GetCharacter(id)
+	case "anime":
+		data, _, err = m.GetCharacterAnime(id)
+	case "manga":
+		data, _, err = m.GetCharacterManga(id)
+	case "ography":
+		t, err := o.mainType()
+		if err != nil {
+			return nil, err
+		}
+		data, _, err = m.GetCharacterOgraphy(t, id)
+		return data, err
+	case "articles":
+		data, _, err = m.GetCharacterArticle(id)
+	case "pictures":
+		data, _, err = m.GetCharacterPicture(id)
+	case "clubs":
+		data, _, err = m.GetCharacterClub(id)
+	case "voice-actors":
+		data, _, err = m.GetCharacterVA(id)
+	default:
+		return nil, invalidPart(o.part)
+	}
+	return data, err
+}
+
+func peopleCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.part {
+	case "":
+		data, _, err = m.GetPeople(id)
+	case "characters":
+		data, _, err = m.GetPeopleCharacter(id)
+	case "staff":
+		data, _, err = m.GetPeopleStaff(id)
+	case "manga":
+		data, _, err = m.GetPeopleManga(id)
+	case "news":
+		data, _, err = m.GetPeopleNews(id)
+	case "articles":
+		data, _, err = m.GetPeopleArticle(id)
+	case "pictures":
+		data, _, err = m.GetPeoplePicture(id)
+	default:
+		return nil, invalidPart(o.part)
+	}
+	return data, err
+}
+
+func producersCmd(m *malscraper.Malscraper, _ []string, _ *options, _ io.Writer) (interface{}, error) {
+	data, _, err := m.GetProducers()
+	return data, err
+}
+
+func producerCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (interface{}, error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := m.GetProducer(id, o.page)
+	return data, err
+}
+
+func magazinesCmd(m *malscraper.Malscraper, _ []string, _ *options, _ io.Writer) (interface{}, error) {
+	data, _, err := m.GetMagazines()
+	return data, err
+}
+
+func magazineCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (interface{}, error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := m.GetMagazine(id, o.page)
+	return data, err
+}
+
+func genresCmd(m *malscraper.Malscraper, _ []string, o *options, _ io.Writer) (interface{}, error) {
+	t, err := o.mainType()
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := m.GetGenres(t)
+	return data, err
+}
+
+func genreCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := o.mainType()
+	if err != nil {
+		return nil, err
+	}
+
+	if t == malscraper.MangaType {
+		data, _, err = m.GetMangaWithGenre(id, o.page)
+	} else {
+		data, _, err = m.GetAnimeWithGenre(id, o.page)
+	}
+	return data, err
+}
+
+func reviewCmd(m *malscraper.Malscraper, args []string, _ *options, _ io.Writer) (interface{}, error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := m.GetReview(id)
+	return data, err
+}
+
+func reviewsCmd(m *malscraper.Malscraper, _ []string, o *options, _ io.Writer) (data interface{}, err error) {
+	switch strings.ToLower(o._type) {
+	case "", "anime":
+		data, _, err = m.GetAnimeReviews(o.page)
+	case "manga":
+		data, _, err = m.GetMangaReviews(o.page)
+	case "best":
+		data, _, err = m.GetBestReviews(o.page)
+	default:
+		return nil, &usageError{msg: "type should be anime, manga, or best"}
+	}
+	return data, err
+}
+
+func recommendationCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (interface{}, error) {
+	id1, err := getIntArg(args, 0, "id1")
+	if err != nil {
+		return nil, err
+	}
+
+	id2, err := getIntArg(args, 1, "id2")
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := o.mainType()
+	if err != nil {
+		return nil, err
+	}
+
+	data, _, err := m.GetRecommendation(t, id1, id2)
+	return data, err
+}
+
+func recommendationsCmd(m *malscraper.Malscraper, _ []string, o *options, _ io.Writer) (interface{}, error) {
+	t, err := o.mainType()
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := m.GetRecommendations(t, o.page)
+	return data, err
+}
+
+func getSeasonYear(args []string) ([]interface{}, error) {
+	var seasonYear []interface{}
+	if len(args) > 0 {
+		seasonYear = append(seasonYear, strings.ToLower(args[0]))
+	}
+	if len(args) > 1 {
+		year, err := getIntArg(args, 1, "year")
+		if err != nil {
+			return nil, err
+		}
+		seasonYear = append(seasonYear, year)
+	}
+	return seasonYear, nil
+}
+
+func seasonCmd(m *malscraper.Malscraper, args []string, _ *options, _ io.Writer) (interface{}, error) {
+	seasonYear, err := getSeasonYear(args)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := m.GetSeason(seasonYear...)
+	return data, err
+}
+
+// scheduleCmd writes iCalendar feed directly to the output
+// regardless the chosen format.
+func scheduleCmd(m *malscraper.Malscraper, args []string, o *options, w io.Writer) (interface{}, error) {
+	seasonYear, err := getSeasonYear(args)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(o.timezone)
+	if err != nil {
+		return nil, &usageError{msg: "invalid time zone " + o.timezone}
+	}
+
+	_, err = m.GetSeasonSchedule(w, loc, seasonYear...)
+	return nil, err
+}
+
+func newsCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	if len(args) == 0 {
+		data, _, err = m.GetNewsList(o.page, o.tag)
+		return data, err
+	}
+
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	data, _, err = m.GetNews(id)
+	return data, err
+}
+
+func newsTagsCmd(m *malscraper.Malscraper, _ []string, _ *options, _ io.Writer) (interface{}, error) {
+	data, _, err := m.GetNewsTag()
+	return data, err
+}
+
+func articleCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	if len(args) == 0 {
+		data, _, err = m.GetArticles(o.page, o.tag)
+		return data, err
+	}
+
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	data, _, err = m.GetArticle(id)
+	return data, err
+}
+
+func articleTagsCmd(m *malscraper.Malscraper, _ []string, _ *options, _ io.Writer) (interface{}, error) {
+	data, _, err := m.GetArticleTag()
+	return data, err
+}
+
+func clubsCmd(m *malscraper.Malscraper, _ []string, o *options, _ io.Writer) (interface{}, error) {
+	data, _, err := m.GetClubs(o.page)
+	return data, err
+}
+
+func clubCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	id, err := getIntArg(args, 0, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.part {
+	case "":
+		data, _, err = m.GetClub(id)
+	case "members":
+		data, _, err = m.GetClubMember(id, o.page)
+	case "pictures":
+		data, _, err = m.GetClubPicture(id)
+	case "related":
+		data, _, err = m.GetClubRelated(id)
+	default:
+		return nil, invalidPart(o.part)
+	}
+	return data, err
+}
+
+func topCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	entity, err := getArg(args, 0, "entity")
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := o.typeConst()
+	if err != nil {
+		return nil, err
+	}
+
+	switch entity {
+	case "anime":
+		data, _, err = m.GetTopAnime(t, o.page)
+	case "manga":
+		data, _, err = m.GetTopManga(t, o.page)
+	case "character":
+		data, _, err = m.GetTopCharacter(o.page)
+	case "people":
+		data, _, err = m.GetTopPeople(o.page)
+	default:
+		return nil, &usageError{msg: "invalid top entity " + entity}
+	}
+	return data, err
+}
+
+func userCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	username, err := getArg(args, 0, "username")
+	if err != nil {
+		return nil, err
+	}
+
+	status := o.status
+	if status == malscraper.StatusDefault {
+		status = malscraper.StatusAll
+	}
+	listQuery := model.UserListQuery{
+		Username: username,
+		Page:     o.page,
+		Status:   status,
+		Order:    o.order,
+		Tag:      o.tag,
+	}
+
+	switch o.part {
+	case "":
+		data, _, err = m.GetUser(username)
+	case "stats":
+		data, _, err = m.GetUserStats(username)
+	case "favorites":
+		data, _, err = m.GetUserFavorite(username)
+	case "friends":
+		data, _, err = m.GetUserFriend(username, o.page)
+	case "history":
+		t := malscraper.AllType
+		if o._type != "" {
+			if t, err = o.mainType(); err != nil {
+				return nil, err
+			}
+		}
+		data, _, err = m.GetUserHistory(username, t)
+	case "reviews":
+		data, _, err = m.GetUserReview(username, o.page)
+	case "recommendations":
+		data, _, err = m.GetUserRecommendation(username, o.page)
+	case "clubs":
+		data, _, err = m.GetUserClub(username)
+	case "animelist":
+		data, _, err = m.GetUserAnimeAdv(listQuery)
+	case "mangalist":
+		data, _, err = m.GetUserMangaAdv(listQuery)
+	case "breakdown":
+		var limit []int
+		if o.limit > 0 {
+			limit = append(limit, o.limit)
+		}
+		data, _, err = m.GetUserAnimeBreakdown(username, limit...)
+	default:
+		return nil, invalidPart(o.part)
+	}
+	return data, err
+}
+
+func compareCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	user1, err := getArg(args, 0, "username1")
+	if err != nil {
+		return nil, err
+	}
+
+	user2, err := getArg(args, 1, "username2")
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := o.mainType()
+	if err != nil {
+		return nil, err
+	}
+
+	if t == malscraper.MangaType {
+		data, _, err = m.CompareUserManga(user1, user2)
+	} else {
+		data, _, err = m.CompareUserAnime(user1, user2)
+	}
+	return data, err
+}
+
+func searchCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	entity, err := getArg(args, 0, "entity")
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.Join(args[1:], " ")
+
+	switch entity {
+	case "anime", "manga":
+		q, err := o.query(query)
+		if err != nil {
+			return nil, err
+		}
+		if entity == "manga" {
+			data, _, err = m.AdvSearchManga(q)
+		} else {
+			data, _, err = m.AdvSearchAnime(q)
+		}
+		return data, err
+	case "character":
+		data, _, err = m.SearchCharacter(query, o.page)
+	case "people":
+		data, _, err = m.SearchPeople(query, o.page)
+	case "club":
+		data, _, err = m.AdvSearchClub(model.ClubQuery{
+			Name:     query,
+			Page:     o.page,
+			Category: o.category,
+			Sort:     o.sort,
+		})
+	case "user":
+		data, _, err = m.AdvSearchUser(model.UserQuery{
+			Username: query,
+			Page:     o.page,
+			Location: o.location,
+			MinAge:   o.minAge,
+			MaxAge:   o.maxAge,
+			Gender:   o.gender,
+		})
+	default:
+		return nil, &usageError{msg: "invalid search entity " + entity}
+	}
+	return data, err
+}
+
+// query to build anime/manga search query from flags.
+func (o *options) query(title string) (model.Query, error) {
+	t, err := o.typeConst()
+	if err != nil {
+		return model.Query{}, err
+	}
+
+	genres, err := o.genreIDs()
+	if err != nil {
+		return model.Query{}, err
+	}
+
+	start, err := parseDate(o.startDate)
+	if err != nil {
+		return model.Query{}, err
+	}
+
+	end, err := parseDate(o.endDate)
+	if err != nil {
+		return model.Query{}, err
+	}
+
+	return model.Query{
+		Title:        title,
+		Page:         o.page,
+		Type:         t,
+		Score:        o.score,
+		Status:       o.status,
+		ProducerID:   o.producer,
+		MagazineID:   o.magazine,
+		Rating:       o.rating,
+		StartDate:    start,
+		EndDate:      end,
+		ExcludeGenre: o.exclude,
+		GenreIDs:     genres,
+		FirstLetter:  o.letter,
+	}, nil
+}
+
+func resolveCmd(m *malscraper.Malscraper, args []string, o *options, _ io.Writer) (data interface{}, err error) {
+	entity, err := getArg(args, 0, "entity")
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.Join(args[1:], " ")
+
+	t, err := o.typeConst()
+	if err != nil {
+		return nil, err
+	}
+
+	hint := model.ResolveHint{
+		Year:          o.year,
+		Type:          t,
+		Episode:       o.episode,
+		MinConfidence: o.minConfidence,
+	}
+
+	switch entity {
+	case "anime":
+		data, _, err = m.ResolveAnime(context.Background(), title, hint)
+	case "manga":
+		data, _, err = m.ResolveManga(context.Background(), title, hint)
+	default:
+		return nil, &usageError{msg: "invalid resolve entity " + entity}
+	}
+	return data, err
+}
+
+func urlCmd(m *malscraper.Malscraper, args []string, _ *options, _ io.Writer) (interface{}, error) {
+	url, err := getArg(args, 0, "url")
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := m.Dispatch(context.Background(), url)
+	return data, err
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	e "errors"
+
+	"github.com/rl404/go-malscraper/errors"
+)
+
+// Exit codes.
+const (
+	exitOK      = 0
+	exitUnknown = 1 // error not from malscraper
+	exitUsage   = 2 // invalid command, argument, or flag
+)
+
+// Exit code for each malscraper error. The codes are fixed so
+// scripts can rely on them. New error should get a new code.
+var exitCodes = []struct {
+	err  error
+	code int
+}{
+	// Internal errors.
+	{err: errors.ErrInitCache, code: 10},
+	{err: errors.ErrPrepareRequest, code: 11},
+	{err: errors.ErrHTTPRequest, code: 12},
+	{err: errors.ErrNot200, code: 13},
+	{err: errors.ErrParseBody, code: 14},
+	{err: errors.ErrDecodeJSON, code: 15},
+	{err: errors.ErrWriteICS, code: 16},
+	{err: errors.ErrBlocked, code: 17},
+	{err: errors.ErrMaintenance, code: 18},
+	{err: errors.ErrCircuitOpen, code: 19},
+
+	// Validation errors.
+	{err: errors.ErrInvalidID, code: 20},
+	{err: errors.Err3LettersSearch, code: 21},
+	{err: errors.ErrInvalidType, code: 22},
+	{err: errors.ErrInvalidSeason, code: 23},
+	{err: errors.ErrInvalidYear, code: 24},
+	{err: errors.ErrInvalidPage, code: 25},
+	{err: errors.ErrInvalidScore, code: 26},
+	{err: errors.ErrInvalidTag, code: 27},
+	{err: errors.ErrInvalidSortType, code: 28},
+	{err: errors.ErrInvalidClubCategory, code: 29},
+	{err: errors.ErrInvalidStatus, code: 30},
+	{err: errors.ErrInvalidRating, code: 31},
+	{err: errors.ErrInvalidProducer, code: 32},
+	{err: errors.ErrInvalidGenre, code: 33},
+	{err: errors.ErrInvalidFirstLetter, code: 34},
+	{err: errors.ErrInvalidMagazine, code: 35},
+	{err: errors.ErrInvalidOrder, code: 36},
+	{err: errors.ErrInvalidUsername, code: 37},
+	{err: errors.ErrInvalidAge, code: 38},
+	{err: errors.ErrInvalidGender, code: 39},
+	{err: errors.ErrInvalidURL, code: 40},
+
+	// Cache and dry-run errors.
+	{err: errors.ErrNotModified, code: 50},
+	{err: errors.ErrPageNotCached, code: 51},
+	{err: errors.ErrDryRun, code: 52},
+	{err: errors.ErrNoPageCache, code: 53},
+
+	// Canceled request.
+	{err: errors.ErrCanceled, code: 60},
+}
+
+// usageError is returned if command, argument, or flag is invalid.
+type usageError struct {
+	msg string
+}
+
+func (err *usageError) Error() string {
+	return err.msg
+}
+
+func getExitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+
+	var uErr *usageError
+	if e.As(err, &uErr) {
+		return exitUsage
+	}
+
+	for _, c := range exitCodes {
+		if e.Is(err, c.err) {
+			return c.code
+		}
+	}
+
+	return exitUnknown
+}
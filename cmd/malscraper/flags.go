@@ -0,0 +1,188 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	malscraper "github.com/rl404/go-malscraper"
+)
+
+// options contains all command flags. Not all flags
+// are used by every command.
+type options struct {
+	// General.
+	format     string
+	logLevel   string
+	noCache    bool
+	cacheTime  time.Duration
+	cacheDir   string
+	legacyEnum bool
+	dryRun     bool
+
+	// Request.
+	part     string
+	page     int
+	_type    string
+	status   int
+	order    int
+	tag      string
+	limit    int
+	timezone string
+
+	// Search query.
+	score     int
+	producer  int
+	magazine  int
+	rating    int
+	startDate string
+	endDate   string
+	genres    string
+	exclude   bool
+	letter    string
+	category  int
+	sort      int
+	location  string
+	minAge    int
+	maxAge    int
+	gender    int
+
+	// Resolver hint.
+	year          int
+	episode       int
+	minConfidence float64
+}
+
+func newFlagSet(o *options) *flag.FlagSet {
+	fs := flag.NewFlagSet("malscraper", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+
+	fs.StringVar(&o.format, "format", "json", "output format (json, table, yaml)")
+	fs.StringVar(&o.logLevel, "log-level", "error", "log level (none, error, info, debug, trace)")
+	fs.BoolVar(&o.noCache, "no-cache", false, "disable caching")
+	fs.DurationVar(&o.cacheTime, "cache-time", 24*time.Hour, "cache expired time")
+	fs.StringVar(&o.cacheDir, "cache-dir", "", "persist cache in this directory")
+	fs.BoolVar(&o.legacyEnum, "legacy-enum", false, "encode anime & manga enums as string")
+	fs.BoolVar(&o.dryRun, "dry-run", false, "print planned requests instead of requesting MyAnimeList")
+
+	fs.StringVar(&o.part, "part", "", "detail part to get")
+	fs.IntVar(&o.page, "page", 1, "page number")
+	fs.StringVar(&o._type, "type", "", "type (anime, manga, or type constant)")
+	fs.IntVar(&o.status, "status", 0, "search/user list status")
+	fs.IntVar(&o.order, "order", malscraper.OrderDefault, "user list order")
+	fs.StringVar(&o.tag, "tag", "", "news/article/user list tag")
+	fs.IntVar(&o.limit, "limit", 0, "user breakdown anime details limit")
+	fs.StringVar(&o.timezone, "tz", "UTC", "season schedule time zone")
+
+	fs.IntVar(&o.score, "score", 0, "search minimum score")
+	fs.IntVar(&o.producer, "producer", 0, "search producer id")
+	fs.IntVar(&o.magazine, "magazine", 0, "search magazine id")
+	fs.IntVar(&o.rating, "rating", 0, "search rating")
+	fs.StringVar(&o.startDate, "start", "", "search start date (2006-01-02)")
+	fs.StringVar(&o.endDate, "end", "", "search end date (2006-01-02)")
+	fs.StringVar(&o.genres, "genre", "", "search genre ids (comma separated)")
+	fs.BoolVar(&o.exclude, "exclude", false, "exclude search genre")
+	fs.StringVar(&o.letter, "letter", "", "search first letter")
+	fs.IntVar(&o.category, "category", malscraper.AllCategory, "club search category")
+	fs.IntVar(&o.sort, "sort", malscraper.SortDefault, "club search sort")
+	fs.StringVar(&o.location, "location", "", "user search location")
+	fs.IntVar(&o.minAge, "min-age", 0, "user search minimum age")
+	fs.IntVar(&o.maxAge, "max-age", 0, "user search maximum age")
+	fs.IntVar(&o.gender, "gender", malscraper.GenderDefault, "user search gender")
+
+	fs.IntVar(&o.year, "year", 0, "resolver start year hint")
+	fs.IntVar(&o.episode, "episode", 0, "resolver episode/chapter count hint")
+	fs.Float64Var(&o.minConfidence, "min-confidence", 0, "resolver minimum confidence")
+
+	return fs
+}
+
+// parseArgs to parse flags which may be placed before,
+// between, or after positional arguments.
+func parseArgs(args []string) (*options, []string, error) {
+	var o options
+	fs := newFlagSet(&o)
+
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, nil, &usageError{msg: err.Error()}
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	return &o, positional, nil
+}
+
+var logLevels = map[string]int{
+	"none":  malscraper.LevelZero,
+	"error": malscraper.LevelError,
+	"info":  malscraper.LevelInfo,
+	"debug": malscraper.LevelDebug,
+	"trace": malscraper.LevelTrace,
+}
+
+func (o *options) getLogLevel() (int, error) {
+	level, ok := logLevels[strings.ToLower(o.logLevel)]
+	if !ok {
+		return 0, &usageError{msg: "invalid log level " + o.logLevel}
+	}
+	return level, nil
+}
+
+// mainType to get `--type` flag as main type (anime or manga).
+// Default is anime.
+func (o *options) mainType() (int, error) {
+	switch strings.ToLower(o._type) {
+	case "", "anime":
+		return malscraper.AnimeType, nil
+	case "manga":
+		return malscraper.MangaType, nil
+	default:
+		return 0, &usageError{msg: "type should be anime or manga"}
+	}
+}
+
+// typeConst to get `--type` flag as type constant.
+func (o *options) typeConst() (int, error) {
+	if o._type == "" {
+		return 0, nil
+	}
+	t, err := strconv.Atoi(o._type)
+	if err != nil {
+		return 0, &usageError{msg: "type should be a number"}
+	}
+	return t, nil
+}
+
+func (o *options) genreIDs() ([]int, error) {
+	var ids []int
+	for _, g := range strings.Split(o.genres, ",") {
+		if g = strings.TrimSpace(g); g == "" {
+			continue
+		}
+		id, err := strconv.Atoi(g)
+		if err != nil {
+			return nil, &usageError{msg: "genre should be comma separated numbers"}
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func parseDate(str string) (time.Time, error) {
+	if str == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse("2006-01-02", str)
+	if err != nil {
+		return time.Time{}, &usageError{msg: "date should be in 2006-01-02 format"}
+	}
+	return t, nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+
+	malscraper "github.com/rl404/go-malscraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArgs(t *testing.T) {
+	t.Run("interleaved", func(t *testing.T) {
+		o, args, err := parseArgs([]string{"1", "--part", "characters", "--format=yaml", "--no-cache", "2"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, args)
+		assert.Equal(t, "characters", o.part)
+		assert.Equal(t, "yaml", o.format)
+		assert.True(t, o.noCache)
+		assert.Equal(t, 1, o.page)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, _, err := parseArgs([]string{"1", "--unknown"})
+		assert.IsType(t, &usageError{}, err)
+	})
+}
+
+func TestOptions(t *testing.T) {
+	o := &options{logLevel: "TRACE", _type: "manga", genres: "1, 2,"}
+
+	level, err := o.getLogLevel()
+	assert.NoError(t, err)
+	assert.Equal(t, malscraper.LevelTrace, level)
+
+	mainType, err := o.mainType()
+	assert.NoError(t, err)
+	assert.Equal(t, malscraper.MangaType, mainType)
+
+	_, err = o.typeConst()
+	assert.IsType(t, &usageError{}, err)
+
+	genres, err := o.genreIDs()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, genres)
+
+	o.logLevel = "verbose"
+	_, err = o.getLogLevel()
+	assert.IsType(t, &usageError{}, err)
+}
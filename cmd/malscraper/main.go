@@ -0,0 +1,161 @@
+// Command malscraper is a command-line tool to call malscraper
+// methods without writing Go code. Useful for debugging.
+//
+// Usage:
+//
+//  malscraper <command> [arguments] [flags]
+//
+// Examples:
+//
+//  malscraper anime 1 --part characters --format table
+//  malscraper user rl404 --part animelist --status 2 --format yaml
+//  malscraper search anime naruto --type 1 --genre 1,2
+//  malscraper url https://myanimelist.net/anime/1/Cowboy_Bebop/reviews?p=2
+//
+// General flags:
+//
+//  --format       output format, json, table, or yaml (default json)
+//  --log-level    log level, none, error, info, debug, or trace (default error)
+//  --no-cache     disable caching
+//  --cache-time   cache expired time (default 24h)
+//  --cache-dir    persist cache in this directory
+//  --legacy-enum  encode anime & manga enums as string
+//  --dry-run      print planned requests instead of requesting MyAnimeList
+//
+// Exit code will be 0 if success, 1 if unknown error, 2 if invalid
+// command usage, and a distinct code for each error in package
+// `errors` (see `exitCodes` in exit.go).
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	malscraper "github.com/rl404/go-malscraper"
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/pkg/cache/filecache"
+	"github.com/rl404/mal-plugin/cache/nocache"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(stderr)
+		return exitUsage
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %s\n\n", args[0])
+		printUsage(stderr)
+		return exitUsage
+	}
+
+	err := execute(cmd, args[1:], stdout)
+	if err != nil {
+		fmt.Fprintln(stderr, "error:", err.Error())
+		if _, ok := err.(*usageError); ok {
+			fmt.Fprintln(stderr, "usage: malscraper", cmd.usage)
+		}
+	}
+
+	return getExitCode(err)
+}
+
+func execute(cmd command, args []string, w io.Writer) error {
+	o, args, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	if o.format != formatJSON && o.format != formatTable && o.format != formatYAML {
+		return &usageError{msg: "invalid format " + o.format}
+	}
+
+	m, err := newMalscraper(o)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	data, err := cmd.fn(m, args, o, w)
+	if o.dryRun && (err == nil || err == errors.ErrDryRun) {
+		return writeOutput(w, o.format, m.Plan())
+	}
+	if err != nil {
+		return err
+	}
+
+	if data == nil {
+		return nil
+	}
+
+	// Table uses enum names already.
+	if o.format != formatTable {
+		data = m.JSONValue(data)
+	}
+
+	return writeOutput(w, o.format, data)
+}
+
+func newMalscraper(o *options) (*malscraper.Malscraper, error) {
+	level, err := o.getLogLevel()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := malscraper.Config{
+		CacheTime:      o.cacheTime,
+		CleanImageURL:  true,
+		CleanVideoURL:  true,
+		LegacyEnumJSON: o.legacyEnum,
+		DryRun:         o.dryRun,
+		LogLevel:       level,
+		LogColor:       true,
+	}
+
+	switch {
+	case o.noCache:
+		cfg.Cacher, _ = nocache.New()
+	case o.cacheDir != "":
+		c, err := filecache.NewWithConfig(filecache.Config{
+			Dir:           o.cacheDir,
+			TTL:           o.cacheTime,
+			Gzip:          true,
+			SweepInterval: -1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cfg.Cacher = c
+	}
+
+	return malscraper.New(cfg)
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: malscraper <command> [arguments] [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintln(w, "  "+commands[name].usage)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "flags:")
+	fs := newFlagSet(&options{})
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
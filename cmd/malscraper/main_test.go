@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	e "errors"
+	"testing"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var errDummy = e.New("dummy error")
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		code int
+	}{
+		{name: "empty", args: nil, code: exitUsage},
+		{name: "unknown-command", args: []string{"unknown"}, code: exitUsage},
+		{name: "missing-arg", args: []string{"anime", "--no-cache"}, code: exitUsage},
+		{name: "invalid-arg", args: []string{"anime", "abc", "--no-cache"}, code: exitUsage},
+		{name: "invalid-part", args: []string{"anime", "1", "--part", "unknown", "--no-cache"}, code: exitUsage},
+		{name: "invalid-type", args: []string{"character", "1", "--part", "ography", "--type", "tv", "--no-cache"}, code: exitUsage},
+		{name: "invalid-format", args: []string{"anime", "1", "--format", "xml", "--no-cache"}, code: exitUsage},
+		{name: "invalid-id", args: []string{"anime", "0", "--no-cache"}, code: 20},
+		{name: "invalid-search", args: []string{"search", "anime", "na", "--no-cache"}, code: 21},
+		{name: "invalid-url", args: []string{"url", "https://example.com", "--no-cache"}, code: 40},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			assert.Equal(t, test.code, run(test.args, &stdout, &stderr))
+			assert.Empty(t, stdout.String())
+			assert.NotEmpty(t, stderr.String())
+		})
+	}
+}
+
+func TestGetExitCode(t *testing.T) {
+	assert.Equal(t, exitOK, getExitCode(nil))
+	assert.Equal(t, exitUsage, getExitCode(&usageError{}))
+	assert.Equal(t, exitUnknown, getExitCode(errDummy))
+
+	codes := make(map[int]bool)
+	for _, c := range exitCodes {
+		assert.Equal(t, c.code, getExitCode(c.err))
+		assert.False(t, codes[c.code], "duplicate exit code %d", c.code)
+		codes[c.code] = true
+	}
+
+	assert.Equal(t, 20, getExitCode(errors.ErrInvalidID))
+	assert.Equal(t, 52, getExitCode(errors.ErrDryRun))
+}
+
+func TestRunDryRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, exitOK, run([]string{"anime", "1", "--no-cache", "--dry-run"}, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "https://myanimelist.net/anime/1")
+	assert.Empty(t, stderr.String())
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats.
+const (
+	formatJSON  = "json"
+	formatTable = "table"
+	formatYAML  = "yaml"
+)
+
+func writeOutput(w io.Writer, format string, data interface{}) error {
+	switch strings.ToLower(format) {
+	case formatJSON:
+		return writeJSON(w, data)
+	case formatYAML:
+		return writeYAML(w, data)
+	case formatTable:
+		return writeTable(w, data)
+	default:
+		return &usageError{msg: "invalid format " + format}
+	}
+}
+
+func writeJSON(w io.Writer, data interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+// writeYAML to write data as YAML with the same field names
+// and order as its JSON.
+func writeYAML(w io.Writer, data interface{}) error {
+	j, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	// JSON is valid YAML (flow style).
+	var node yaml.Node
+	if err := yaml.Unmarshal(j, &node); err != nil {
+		return err
+	}
+	setBlockStyle(&node)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func setBlockStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle | yaml.DoubleQuotedStyle
+	for _, n := range node.Content {
+		setBlockStyle(n)
+	}
+}
+
+// writeTable to write data as table. List of struct will be
+// shown as rows with its non-nested fields as columns. Single
+// struct will be shown as field-value rows.
+func writeTable(w io.Writer, data interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	v := indirect(reflect.ValueOf(data))
+	switch {
+	case !v.IsValid():
+	case v.Kind() == reflect.Slice && indirectType(v.Type().Elem()).Kind() == reflect.Struct:
+		writeTableRows(tw, v)
+	case v.Kind() == reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			fmt.Fprintln(tw, cellValue(v.Index(i)))
+		}
+	case v.Kind() == reflect.Struct:
+		for _, f := range getFields(v.Type()) {
+			fmt.Fprintf(tw, "%s\t%s\n", f.name, cellValue(v.Field(f.index)))
+		}
+	default:
+		fmt.Fprintln(tw, cellValue(v))
+	}
+
+	return tw.Flush()
+}
+
+func writeTableRows(w io.Writer, v reflect.Value) {
+	var fields []field
+	for _, f := range getFields(indirectType(v.Type().Elem())) {
+		if isScalar(f.typ) {
+			fields = append(fields, f)
+		}
+	}
+
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = strings.ToUpper(f.name)
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for i := 0; i < v.Len(); i++ {
+		row := indirect(v.Index(i))
+		cells := make([]string, len(fields))
+		for j, f := range fields {
+			cells[j] = cellValue(row.Field(f.index))
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+}
+
+type field struct {
+	index int
+	name  string
+	typ   reflect.Type
+}
+
+// getFields to get exported struct fields with their JSON name.
+func getFields(t reflect.Type) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		fields = append(fields, field{index: i, name: name, typ: f.Type})
+	}
+	return fields
+}
+
+func cellValue(v reflect.Value) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		return "-"
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	switch {
+	case isScalar(v.Type()):
+		str := strings.Join(strings.Fields(fmt.Sprint(v.Interface())), " ")
+		if str == "" {
+			return "-"
+		}
+		return str
+	case v.Kind() == reflect.Slice && isScalar(v.Type().Elem()):
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		if len(items) == 0 {
+			return "-"
+		}
+		return strings.Join(items, ", ")
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Map:
+		if v.Len() == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("[%d items]", v.Len())
+	default:
+		j, _ := json.Marshal(v.Interface())
+		return string(j)
+	}
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		v = v.Elem()
+	}
+	return v
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var testData = []model.AnimeItem{
+	{ID: 1, Title: "Cowboy Bebop", Type: model.AnimeTypeTV, Genres: []model.Item{{ID: 1, Name: "Action"}}},
+	{ID: 5, Title: "Cowboy Bebop: Tengoku no Tobira", Type: model.AnimeTypeMovie},
+}
+
+func TestWriteOutput(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.IsType(t, &usageError{}, writeOutput(&buf, "xml", testData))
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, writeOutput(&buf, formatYAML, model.Item{ID: 1, Name: "Action"}))
+		assert.Equal(t, "id: 1\nname: Action\n", buf.String())
+	})
+
+	t.Run("table-list", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, writeOutput(&buf, formatTable, []model.Item{{ID: 1, Name: "Action"}, {ID: 22, Name: "Romance"}}))
+		assert.Equal(t, "ID  NAME\n1   Action\n22  Romance\n", buf.String())
+	})
+
+	t.Run("table-struct", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, writeOutput(&buf, formatTable, &testData[0]))
+		out := buf.String()
+		assert.Contains(t, out, "title          Cowboy Bebop\n")
+		assert.Contains(t, out, "type           TV\n")
+		assert.Contains(t, out, "genres         [1 items]\n")
+	})
+
+	t.Run("table-strings", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, writeOutput(&buf, formatTable, []string{"a", "b"}))
+		assert.Equal(t, "a\nb\n", buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, writeOutput(&buf, formatJSON, model.Item{ID: 1, Name: "Action"}))
+		assert.Equal(t, "{\n  \"id\": 1,\n  \"name\": \"Action\"\n}\n", buf.String())
+	})
+}
@@ -1,9 +1,12 @@
 package malscraper
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/model"
 	"github.com/rl404/go-malscraper/service"
 	"github.com/rl404/mal-plugin/cache/bigcache"
 	"github.com/rl404/mal-plugin/log/mallogger"
@@ -17,6 +20,66 @@ type Config struct {
 	// Cache expired time. Will be used to initiating `Cacher`
 	// using in-memory (bigcache) if `Cacher` is empty.
 	CacheTime time.Duration
+	// Anime & manga search result cache expired time. Should be
+	// shorter than `CacheTime`. Default is 1 hour.
+	SearchCacheTime time.Duration
+	// Keep cached data for this long after it is expired, so it
+	// can still be returned while the circuit breaker is open
+	// (MyAnimeList is unavailable). Requires `CacheTime` to be set
+	// and `Cacher` expired time (if using your own cacher) to be
+	// at least `CacheTime` + `StaleCacheTime`. Zero value disables it.
+	StaleCacheTime time.Duration
+	// Save MyAnimeList response `ETag` and `Last-Modified` header and
+	// send them (`If-None-Match` and `If-Modified-Since`) when refreshing
+	// expired cached data. If the page is not modified, the expired data
+	// will be used and cached again without downloading and parsing the
+	// page. Expired data is kept for `StaleCacheTime` which will be the
+	// same as `CacheTime` if not set. Requires `CacheTime` to be set.
+	ConditionalRequest bool
+
+	// Cacher to save raw MyAnimeList pages (HTML or JSON) separately from
+	// the parsed data. Should have its own expired time, for example
+	// `pkg/cache/filecache`. Use `ReparseCache()` to parse the saved pages
+	// again without requesting MyAnimeList after the parser is fixed.
+	PageCacher service.Cacher
+	// Saved raw pages older than this won't be used. Zero means they can be
+	// used as long as they are still in `PageCacher`.
+	PageCacheTime time.Duration
+
+	// Don't request MyAnimeList. Requests are recorded and return
+	// `ErrDryRun` instead while cached data is still returned. Use
+	// `Plan()` to see which requests would be sent and how many
+	// cached data would be used. Useful to estimate how many requests
+	// a big crawl needs.
+	DryRun bool
+
+	// Stop requesting MyAnimeList and return `ErrCircuitOpen` after
+	// this many consecutive failed requests (timeout, 5xx, blocked,
+	// or under maintenance). Zero value disables the circuit breaker.
+	CircuitBreakerThreshold int
+	// How long the circuit breaker stays open before letting a request
+	// through to check if MyAnimeList is available again. Default is
+	// 30 seconds.
+	CircuitBreakerTimeout time.Duration
+
+	// Negative cache (failed request which is cached so it won't be
+	// requested again) expired time for each failure class. Zero value
+	// keeps the old behaviour, caching only 404 response until the main
+	// cache expired. For more information, read `model.NegativeCacheTTL`.
+	NegativeCache model.NegativeCacheTTL
+	// Negative cache expired time for specific entity which overrides
+	// `NegativeCache`. Key should be one of `EntityAnime`, `EntityManga`,
+	// `EntityCharacter`, `EntityPeople`, `EntityUser`, `EntityClub`,
+	// `EntityNews`, `EntityArticle`, or `EntityReview`.
+	NegativeCacheEntity map[string]model.NegativeCacheTTL
+
+	// Load and cache validator reference data (anime & manga genres,
+	// producers, magazines, news tags, and article tags) when they are
+	// first needed. Otherwise, they are only used for validation if they
+	// are already cached. Use `Warmup()` to load them at startup.
+	LoadReference bool
+	// Validator reference data refresh time. Default is 1 day.
+	ReferenceRefreshTime time.Duration
 
 	// Does malscraper need to automatically clean any image and video url.
 	// For more information, please read `ImageURLCleaner()` and `VideoURLCleaner()`
@@ -24,6 +87,33 @@ type Config struct {
 	CleanImageURL bool
 	CleanVideoURL bool
 
+	// Http client interface used to request MyAnimeList. Can use
+	// your own http client or `pkg/proxypool` to spread requests
+	// across several proxies. Default is `*http.Client` with
+	// 10 seconds timeout.
+	HTTPClient service.HTTPClient
+	// Headers for every request to MyAnimeList.
+	Headers map[string]string
+	// User-Agent provider interface. Can use `pkg/useragent` to
+	// rotate through a list of User-Agents. Overrides User-Agent
+	// in `Headers`.
+	UserAgent service.UserAgent
+	// Called before every request to MyAnimeList is sent. Can be
+	// used to set per-request headers (`Accept-Language`, etc).
+	// Overrides all headers above.
+	RequestHook func(*http.Request)
+
+	// GraphQL query limits. Query with more nested fields than
+	// `GraphQLMaxDepth` (default 8) or more estimated MyAnimeList
+	// requests than `GraphQLMaxCost` (default 200) is rejected.
+	// For more information, read `GraphQL()`.
+	GraphQLMaxDepth int
+	GraphQLMaxCost  int
+
+	// Metrics observer interface. Can use your own observer
+	// to export malscraper metrics (negative cache hit, etc).
+	Observer service.Observer
+
 	// Log interface. Can use your own logger interface.
 	Logger service.Logger
 	// Log Level. Show only error as default. Value should be chosen from constant.
@@ -31,6 +121,17 @@ type Config struct {
 	LogLevel int
 	// Colorful log. Will be used to intiating `Logger` if `Logger` is empty.
 	LogColor bool
+
+	// Encode anime & manga type, status, rating, and source as
+	// their original string instead of integer, just like the old
+	// version. Only affects data encoded with `JSONValue()` of the
+	// malscraper instance. For more information, please read
+	// `LegacyEnum()` in `model/legacy.go`.
+	//
+	// Cached data always uses the integer encoding. Data cached
+	// before the enums were introduced has older cache version so
+	// it is requested again instead of being used.
+	LegacyEnumJSON bool
 }
 
 // Testable function.
@@ -41,11 +142,37 @@ func (c *Config) init() (err error) {
 		c.Logger = mallogger.New(c.LogLevel, c.LogColor)
 	}
 
+	if c.Observer == nil {
+		c.Observer = internal.NopObserver{}
+	}
+
+	if c.SearchCacheTime <= 0 {
+		c.SearchCacheTime = time.Hour
+	}
+
+	if c.LoadReference && c.ReferenceRefreshTime <= 0 {
+		c.ReferenceRefreshTime = 24 * time.Hour
+	}
+
+	if c.CircuitBreakerThreshold > 0 && c.CircuitBreakerTimeout <= 0 {
+		c.CircuitBreakerTimeout = 30 * time.Second
+	}
+
+	if c.Cacher == nil && c.CacheTime <= 0 {
+		c.CacheTime = 24 * time.Hour
+	}
+
+	if c.ConditionalRequest && c.StaleCacheTime <= 0 {
+		c.StaleCacheTime = c.CacheTime
+	}
+
+	// Expired data can't be kept without knowing when it is expired.
+	if c.CacheTime <= 0 && (c.ConditionalRequest || c.StaleCacheTime > 0) {
+		c.Logger.Warn("conditional request and stale cache are disabled, they require CacheTime to be set")
+	}
+
 	if c.Cacher == nil {
-		if c.CacheTime <= 0 {
-			c.CacheTime = 24 * time.Hour
-		}
-		c.Cacher, err = createCache(c.CacheTime)
+		c.Cacher, err = createCache(c.CacheTime + c.StaleCacheTime)
 		if err != nil {
 			c.Logger.Error("failed initiating cache: %s", err.Error())
 			return errors.ErrInitCache
@@ -30,6 +30,24 @@ const (
 	Fall   = "fall"
 )
 
+// URL entities.
+// Used for parsing and building MyAnimeList URL.
+const (
+	EntityAnime      = "anime"
+	EntityManga      = "manga"
+	EntityCharacter  = "character"
+	EntityPeople     = "people"
+	EntityUser       = "user"
+	EntityClub       = "club"
+	EntityNews       = "news"
+	EntityArticle    = "article"
+	EntityReview     = "review"
+	EntityProducer   = "producer"
+	EntityMagazine   = "magazine"
+	EntityAnimeGenre = "anime-genre"
+	EntityMangaGenre = "manga-genre"
+)
+
 // Top anime types.
 const (
 	TopDefault = iota
@@ -21,6 +21,26 @@ var (
 	ErrParseBody = errors.New("failed parsing request body")
 	// ErrDecodeJSON if failed unmarshaling JSON.
 	ErrDecodeJSON = errors.New("failed decoding JSON")
+	// ErrWriteICS if failed writing iCalendar feed.
+	ErrWriteICS = errors.New("failed writing iCalendar feed")
+	// ErrBlocked if MyAnimeList blocks the request (too many requests or captcha page).
+	ErrBlocked = errors.New("blocked by MyAnimeList, too many requests")
+	// ErrMaintenance if MyAnimeList is under maintenance.
+	ErrMaintenance = errors.New("MyAnimeList is under maintenance")
+	// ErrCircuitOpen if requests to MyAnimeList are stopped for a while after too many failures.
+	ErrCircuitOpen = errors.New("MyAnimeList is unavailable, circuit breaker is open")
+	// ErrCanceled if the request is canceled or its deadline is exceeded.
+	ErrCanceled = errors.New("request canceled")
+	// ErrNotModified if MyAnimeList page is not modified since the last request.
+	ErrNotModified = errors.New("MyAnimeList page not modified")
+	// ErrPageNotCached if raw MyAnimeList page is not in page cache.
+	ErrPageNotCached = errors.New("MyAnimeList page not cached")
+	// ErrNoPageCache if page cache is needed but `PageCacher` is not set.
+	ErrNoPageCache = errors.New("page cache is not set")
+	// ErrDryRun if MyAnimeList is not requested because of dry-run mode.
+	ErrDryRun = errors.New("MyAnimeList not requested in dry-run mode")
+	// ErrInvalidURL if URL is not a valid MyAnimeList URL.
+	ErrInvalidURL = errors.New("invalid MyAnimeList URL")
 	// ErrInvalidID if id is invalid (must positive and not zero).
 	ErrInvalidID = errors.New("invalid ID")
 	// Err3LettersSearch if search query string is less than 3 letters.
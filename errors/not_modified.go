@@ -0,0 +1,21 @@
+package errors
+
+import "time"
+
+// NotModifiedError is returned if MyAnimeList page is not
+// modified since the last request. It matches `ErrNotModified`
+// when checked with `errors.Is()`.
+type NotModifiedError struct {
+	// When the page was last changed as far as known.
+	Since time.Time
+}
+
+// Error to get the error message.
+func (e *NotModifiedError) Error() string {
+	return ErrNotModified.Error()
+}
+
+// Is to check if the target is `ErrNotModified`.
+func (e *NotModifiedError) Is(target error) bool {
+	return target == ErrNotModified
+}
@@ -0,0 +1,16 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotModifiedError(t *testing.T) {
+	var err error = &NotModifiedError{Since: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)}
+	assert.EqualError(t, err, ErrNotModified.Error())
+	assert.True(t, errors.Is(err, ErrNotModified))
+	assert.False(t, errors.Is(err, ErrNot200))
+}
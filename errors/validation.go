@@ -0,0 +1,86 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError is a validation error of a field.
+type FieldError struct {
+	// Field name.
+	Field string
+	// Rejected value.
+	Value interface{}
+	// One of the errors in this package.
+	Err error
+}
+
+// Error to get the error message with the field name and value.
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s %v: %s", e.Field, e.Value, e.Err.Error())
+}
+
+// Unwrap to get the original error.
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError contains all failing fields of a query.
+//
+// Can be checked with `errors.Is()` for each field's error.
+//
+//	if errors.Is(err, errors.ErrInvalidScore) {
+//		// handle invalid score
+//	}
+//
+//	var vErr *errors.ValidationError
+//	if errors.As(err, &vErr) {
+//		for _, f := range vErr.Fields {
+//			fmt.Println(f.Field, f.Value, f.Err)
+//		}
+//	}
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Add to add failing field.
+func (e *ValidationError) Add(field string, value interface{}, err error) {
+	e.Fields = append(e.Fields, FieldError{
+		Field: field,
+		Value: value,
+		Err:   err,
+	})
+}
+
+// Error to get the error message. If there is only 1 failing
+// field, the message will be the same as the field's error.
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 1 {
+		return e.Fields[0].Err.Error()
+	}
+
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is to check if one of the failing fields has the error.
+func (e *ValidationError) Is(err error) bool {
+	for _, f := range e.Fields {
+		if f.Err == err {
+			return true
+		}
+	}
+	return false
+}
+
+// OrNil to return nil if there is no failing field. Useful
+// to return the validation error as `error`.
+func (e *ValidationError) OrNil() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,36 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationError(t *testing.T) {
+	var vErr ValidationError
+	assert.NoError(t, vErr.OrNil())
+
+	vErr.Add("Score", 11, ErrInvalidScore)
+	err := vErr.OrNil()
+	assert.EqualError(t, err, ErrInvalidScore.Error())
+	assert.True(t, errors.Is(err, ErrInvalidScore))
+	assert.False(t, errors.Is(err, ErrInvalidGenre))
+
+	vErr.Add("GenreIDs", 999, ErrInvalidGenre)
+	err = vErr.OrNil()
+	assert.EqualError(t, err, "invalid score; invalid genre")
+	assert.True(t, errors.Is(err, ErrInvalidScore))
+	assert.True(t, errors.Is(err, ErrInvalidGenre))
+
+	var target *ValidationError
+	assert.True(t, errors.As(err, &target))
+	assert.Len(t, target.Fields, 2)
+	assert.Equal(t, FieldError{Field: "GenreIDs", Value: 999, Err: ErrInvalidGenre}, target.Fields[1])
+}
+
+func TestFieldError(t *testing.T) {
+	err := FieldError{Field: "Score", Value: 11, Err: ErrInvalidScore}
+	assert.EqualError(t, err, "Score 11: invalid score")
+	assert.True(t, errors.Is(err, ErrInvalidScore))
+}
@@ -4,8 +4,10 @@ go 1.15
 
 require (
 	github.com/PuerkitoBio/goquery v1.7.1
+	github.com/graphql-go/graphql v0.8.1
 	github.com/grokify/html-strip-tags-go v0.0.1
 	github.com/rl404/mal-plugin v0.3.18
 	github.com/stretchr/objx v0.3.0 // indirect
 	github.com/stretchr/testify v1.7.0
+	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
 )
@@ -10,20 +10,34 @@ import (
 // data to cache before actually access and parse
 // MyAnimeList web.
 type Cacher struct {
-	api    service.API
-	cacher service.Cacher
-	logger service.Logger
+	api          service.API
+	cacher       service.Cacher
+	searchCacher service.Cacher
+	logger       service.Logger
 }
 
-// New to create new cacher.
-func New(api service.API, c service.Cacher, l service.Logger) service.API {
+// New to create new cacher. Anime & manga search result
+// will be cached for `searchTime` duration.
+func New(api service.API, c service.Cacher, l service.Logger, searchTime time.Duration) service.API {
+	cl := newCacherLog(c, l)
 	return &Cacher{
-		api:    api,
-		cacher: newCacherLog(c, l),
-		logger: l,
+		api:          api,
+		cacher:       cl,
+		searchCacher: newSearchCacher(c, l, searchTime),
+		logger:       l,
 	}
 }
 
+// newSearchCacher to create cacher with search cache time. In
+// dry-run mode, cache usage is recorded after the expired time
+// check so expired search result is recorded as miss.
+func newSearchCacher(c service.Cacher, l service.Logger, searchTime time.Duration) service.Cacher {
+	if pc, ok := c.(*planCacher); ok {
+		return NewPlan(newTTLCacher(newCacherLog(pc.cacher, l), searchTime), pc.planner)
+	}
+	return newTTLCacher(newCacherLog(c, l), searchTime)
+}
+
 // Simple cacher wrapper with log to prevent writing
 // repetitive log code.
 type cacherLog struct {
@@ -53,6 +67,17 @@ func (c cacherLog) Get(key string, data interface{}) error {
 	return nil
 }
 
+// GetExpired to get expired data from cache with log.
+func (c cacherLog) GetExpired(key string, data interface{}) (time.Time, error) {
+	c.logger.Trace("[%s] retrieving expired cache...", key)
+	cachedAt, err := getExpired(c.cacher, key, data)
+	if err != nil {
+		c.logger.Warn("[%s] failed retrieving expired cache: %s", key, err.Error())
+		return time.Time{}, err
+	}
+	return cachedAt, nil
+}
+
 // Set to save data to cache with log.
 func (c cacherLog) Set(key string, data interface{}) error {
 	c.logger.Trace("[%s] saving cache...", key)
@@ -21,7 +21,7 @@ func TestNew(t *testing.T) {
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := new(mocks.Logger)
-	_ = New(mockAPI, mockCacher, mockLogger)
+	_ = New(mockAPI, mockCacher, mockLogger, time.Hour)
 }
 
 func TestGet(t *testing.T) {
@@ -9,114 +9,84 @@ import (
 
 // GetCharacter to get character detail information.
 func (c *Cacher) GetCharacter(id int) (data *model.Character, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyCharacter, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetCharacter(id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetCharacter(id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetCharacterArticle to get character featured article list.
 func (c *Cacher) GetCharacterArticle(id int) (data []model.ArticleItem, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyCharacterArticle, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetCharacterArticle(id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetCharacterArticle(id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetCharacterOgraphy to get character animeography/mangaography list.
 func (c *Cacher) GetCharacterOgraphy(t string, id int) (data []model.Role, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyCharacterOgraphy, t, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetCharacterOgraphy(t, id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetCharacterOgraphy(t, id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetCharacterPicture to get character picture list.
 func (c *Cacher) GetCharacterPicture(id int) (data []string, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyCharacterPicture, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetCharacterPicture(id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetCharacterPicture(id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetCharacterClub to get character club list.
 func (c *Cacher) GetCharacterClub(id int) (data []model.ClubItem, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyCharacterClub, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetCharacterClub(id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetCharacterClub(id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetCharacterVA to get character club list.
 func (c *Cacher) GetCharacterVA(id int) (data []model.Role, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyCharacterVA, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetCharacterVA(id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetCharacterVA(id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
@@ -9,95 +9,70 @@ import (
 
 // GetClubs to get club list.
 func (c *Cacher) GetClubs(page int) (data []model.ClubSearch, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyClubs, page)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetClubs(page)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetClubs(page)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetClub to get club detail information.
 func (c *Cacher) GetClub(id int) (data *model.Club, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyClub, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetClub(id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetClub(id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetClubMember to get club member list.
 func (c *Cacher) GetClubMember(id int, page int) (data []model.ClubMember, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyClubMember, id, page)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetClubMember(id, page)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetClubMember(id, page)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetClubPicture to get club picture list.
 func (c *Cacher) GetClubPicture(id int) (data []string, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyClubPicture, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetClubPicture(id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetClubPicture(id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetClubRelated to get club related list.
 func (c *Cacher) GetClubRelated(id int) (data *model.ClubRelated, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyClubRelated, id)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetClubRelated(id)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetClubRelated(id)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
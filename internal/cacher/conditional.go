@@ -0,0 +1,80 @@
+package cacher
+
+import (
+	e "errors"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/service"
+)
+
+// expiredGetter is cacher which can still get expired data.
+type expiredGetter interface {
+	// Get data even if it is already expired and return
+	// the time the data was cached.
+	GetExpired(key string, data interface{}) (time.Time, error)
+}
+
+// getExpired to get data even if it is already expired.
+func getExpired(c service.Cacher, key string, data interface{}) (time.Time, error) {
+	g, ok := c.(expiredGetter)
+	if !ok {
+		return time.Time{}, errExpired
+	}
+	return g.GetExpired(key, data)
+}
+
+// get to get data from cache. If not found, call `fn` which
+// requests MyAnimeList and assigns the parsed data to `data`,
+// then save it to cache. `data` should be a pointer. If the page
+// is not modified since the expired data was cached, the expired
+// data is used, otherwise `fn` is called again without the
+// conditional request.
+func (c *Cacher) get(cacher service.Cacher, key string, data interface{}, fn func() (int, error)) (int, error) {
+	if cacher.Get(key, data) == nil {
+		return http.StatusOK, nil
+	}
+
+	code, err := fn()
+	if isNotModified(err) {
+		if c.getNotModified(cacher, key, data, err) {
+			return http.StatusOK, nil
+		}
+		code, err = fn()
+	}
+	if err != nil {
+		return code, err
+	}
+
+	// Save to cache. Won't return error.
+	_ = cacher.Set(key, reflect.ValueOf(data).Elem().Interface())
+	return http.StatusOK, nil
+}
+
+// isNotModified to check if MyAnimeList page is not modified
+// since the last conditional request.
+func isNotModified(err error) bool {
+	return e.Is(err, errors.ErrNotModified)
+}
+
+// getNotModified to get the expired cached data after MyAnimeList
+// says the page is not modified. The data is cached again to refresh
+// its expired time. Returns false if the data is gone or cached before
+// the page was last changed, so the page should be requested again.
+func (c *Cacher) getNotModified(cacher service.Cacher, key string, data interface{}, err error) bool {
+	var nmErr *errors.NotModifiedError
+	if !e.As(err, &nmErr) {
+		return false
+	}
+
+	cachedAt, err := getExpired(cacher, key, data)
+	if err != nil || cachedAt.Before(nmErr.Since) {
+		return false
+	}
+
+	c.logger.Debug("[%s] not modified, refreshing cache", key)
+	_ = cacher.Set(key, data)
+	return true
+}
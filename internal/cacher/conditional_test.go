@@ -0,0 +1,99 @@
+package cacher
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/rl404/mal-plugin/cache/bigcache"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNotModified(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	bc, err := bigcache.New(time.Hour)
+	assert.NoError(t, err)
+
+	l := mallogger.New(0, false)
+	sc := NewStale(bc, time.Minute, func() bool { return false }, l, new(mocks.Observer))
+	c := &Cacher{cacher: newCacherLog(sc, l), logger: l}
+
+	cachedAt := now
+	assert.NoError(t, c.cacher.Set("key", "data"))
+	now = now.Add(time.Hour)
+
+	t.Run("not-not-modified", func(t *testing.T) {
+		var data string
+		assert.False(t, c.getNotModified(c.cacher, "key", &data, errors.ErrNot200))
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		var data string
+		assert.False(t, c.getNotModified(c.cacher, "missing", &data, &errors.NotModifiedError{}))
+	})
+
+	t.Run("changed-after-cached", func(t *testing.T) {
+		var data string
+		assert.False(t, c.getNotModified(c.cacher, "key", &data, &errors.NotModifiedError{Since: cachedAt.Add(time.Second)}))
+	})
+
+	t.Run("not-supported", func(t *testing.T) {
+		var data string
+		assert.False(t, c.getNotModified(bc, "key", &data, &errors.NotModifiedError{}))
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		var data string
+		assert.Error(t, c.cacher.Get("key", &data))
+		assert.True(t, c.getNotModified(c.cacher, "key", &data, &errors.NotModifiedError{Since: cachedAt}))
+		assert.Equal(t, "data", data)
+
+		// Refreshed.
+		data = ""
+		assert.NoError(t, c.cacher.Get("key", &data))
+		assert.Equal(t, "data", data)
+	})
+}
+
+func TestGetAnimeNotModified(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	bc, err := bigcache.New(time.Hour)
+	assert.NoError(t, err)
+
+	l := mallogger.New(0, false)
+	mockParser := new(mocks.API)
+	sc := NewStale(bc, time.Minute, func() bool { return false }, l, new(mocks.Observer))
+	c := New(mockParser, sc, l, time.Minute)
+
+	t.Run("cached-data-is-gone", func(t *testing.T) {
+		mockParser.On("GetAnime", 1).Return(nil, http.StatusNotModified, &errors.NotModifiedError{}).Once()
+		mockParser.On("GetAnime", 1).Return(&model.Anime{ID: 1}, http.StatusOK, nil).Once()
+
+		d, code, err := c.GetAnime(1)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 1, d.ID)
+	})
+
+	t.Run("not-modified", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		mockParser.On("GetAnime", 1).Return(nil, http.StatusNotModified, &errors.NotModifiedError{}).Once()
+
+		d, code, err := c.GetAnime(1)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 1, d.ID)
+	})
+
+	mockParser.AssertExpectations(t)
+}
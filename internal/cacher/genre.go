@@ -9,57 +9,42 @@ import (
 
 // GetGenres to get anime/manga genre list.
 func (c *Cacher) GetGenres(t string) (data []model.ItemCount, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyGenres, t)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetGenres(t)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetGenres(t)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetAnimeWithGenre to get anime list with specific genre.
 func (c *Cacher) GetAnimeWithGenre(id int, page int) (data []model.AnimeItem, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyAnimeWithGenre, id, page)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetAnimeWithGenre(id, page)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetAnimeWithGenre(id, page)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // GetMangaWithGenre to get manga list with specific genre.
 func (c *Cacher) GetMangaWithGenre(id int, page int) (data []model.MangaItem, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeyMangaWithGenre, id, page)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.GetMangaWithGenre(id, page)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.GetMangaWithGenre(id, page)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
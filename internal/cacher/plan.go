@@ -0,0 +1,55 @@
+package cacher
+
+import (
+	"time"
+
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Cacher wrapper which records cache hits and misses
+// in dry-run mode.
+type planCacher struct {
+	cacher  service.Cacher
+	planner *internal.Planner
+}
+
+// NewPlan to create new cacher wrapper which records
+// cache usage to planner.
+func NewPlan(c service.Cacher, p *internal.Planner) service.Cacher {
+	return &planCacher{
+		cacher:  c,
+		planner: p,
+	}
+}
+
+// Get to get data from cache and record the result.
+func (c planCacher) Get(key string, data interface{}) error {
+	if err := c.cacher.Get(key, data); err != nil {
+		c.planner.AddMiss(key)
+		return err
+	}
+	c.planner.AddHit(key)
+	return nil
+}
+
+// GetExpired to get expired data from cache.
+func (c planCacher) GetExpired(key string, data interface{}) (time.Time, error) {
+	return getExpired(c.cacher, key, data)
+}
+
+// Set to save data to cache.
+func (c planCacher) Set(key string, data interface{}) error {
+	return c.cacher.Set(key, data)
+}
+
+// Delete to delete data in cache.
+func (c planCacher) Delete(key string) error {
+	return c.cacher.Delete(key)
+}
+
+// Close does nothing. The main cacher should be
+// closed by its owner.
+func (c planCacher) Close() error {
+	return nil
+}
@@ -0,0 +1,62 @@
+package cacher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPlanCacher(t *testing.T) {
+	mockCacher := new(mocks.Cacher)
+	p := internal.NewPlanner()
+	c := NewPlan(mockCacher, p)
+
+	var data string
+	mockCacher.On("Get", "mal:anime:1", mock.Anything).Return(nil).Once()
+	mockCacher.On("Get", "mal:anime:2", mock.Anything).Return(errors.New("not found")).Twice()
+	mockCacher.On("Get", "mal:user:rl404", mock.Anything).Return(errors.New("not found")).Once()
+	assert.NoError(t, c.Get("mal:anime:1", &data))
+	assert.Error(t, c.Get("mal:anime:2", &data))
+	assert.Error(t, c.Get("mal:anime:2", &data))
+	assert.Error(t, c.Get("mal:user:rl404", &data))
+
+	assert.Equal(t, []model.PlanKey{
+		{Prefix: "mal:anime", Hit: 1, Miss: 1},
+		{Prefix: "mal:user", Hit: 0, Miss: 1},
+	}, p.Plan().Keys)
+
+	mockCacher.On("Set", "key", "data").Return(nil).Once()
+	assert.NoError(t, c.Set("key", "data"))
+
+	assert.NoError(t, c.Close())
+	mockCacher.AssertExpectations(t)
+}
+
+func TestPlanSearchCacher(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockCacher := new(mocks.Cacher)
+	p := internal.NewPlanner()
+	c := newSearchCacher(NewPlan(mockCacher, p), mallogger.New(0, false), time.Hour)
+
+	// Expired search result.
+	var data string
+	mockCacher.On("Get", "mal:search-anime:a", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(*ttlData).ExpiredAt = now
+	}).Return(nil).Once()
+	assert.Error(t, c.Get("mal:search-anime:a", &data))
+
+	assert.Equal(t, []model.PlanKey{
+		{Prefix: "mal:search-anime", Hit: 0, Miss: 1},
+	}, p.Plan().Keys)
+	mockCacher.AssertExpectations(t)
+}
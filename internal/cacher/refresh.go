@@ -0,0 +1,43 @@
+package cacher
+
+import (
+	"errors"
+
+	"github.com/rl404/go-malscraper/service"
+)
+
+var errRefresh = errors.New("cache is being refreshed")
+
+// Cacher wrapper which never returns cached data so
+// the data is always parsed again and replaces the
+// cached one.
+type refreshCacher struct {
+	cacher service.Cacher
+}
+
+// NewRefresh to create new cacher wrapper which only
+// saves data.
+func NewRefresh(c service.Cacher) service.Cacher {
+	return &refreshCacher{cacher: c}
+}
+
+// Get always returns error.
+func (c refreshCacher) Get(key string, data interface{}) error {
+	return errRefresh
+}
+
+// Set to save data to cache.
+func (c refreshCacher) Set(key string, data interface{}) error {
+	return c.cacher.Set(key, data)
+}
+
+// Delete to delete data in cache.
+func (c refreshCacher) Delete(key string) error {
+	return c.cacher.Delete(key)
+}
+
+// Close does nothing. The main cacher should be
+// closed by its owner.
+func (c refreshCacher) Close() error {
+	return nil
+}
@@ -0,0 +1,25 @@
+package cacher
+
+import (
+	"testing"
+
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshCacher(t *testing.T) {
+	mockCacher := new(mocks.Cacher)
+	c := NewRefresh(mockCacher)
+
+	var data string
+	assert.EqualError(t, c.Get("key", &data), errRefresh.Error())
+
+	mockCacher.On("Set", "key", "data").Return(nil).Once()
+	assert.NoError(t, c.Set("key", "data"))
+
+	mockCacher.On("Delete", "key").Return(nil).Once()
+	assert.NoError(t, c.Delete("key"))
+
+	assert.NoError(t, c.Close())
+	mockCacher.AssertExpectations(t)
+}
@@ -1,94 +1,132 @@
 package cacher
 
 import (
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/rl404/go-malscraper/internal"
 	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/utils"
 )
 
-// SearchAnime to search anime (no caching).
+// SearchAnime to search anime.
 func (c *Cacher) SearchAnime(query model.Query) (data []model.AnimeSearch, code int, err error) {
-	return c.api.SearchAnime(query)
+	// Get from cache or parse.
+	key := internal.GetKey(internal.KeySearchAnime, queryToParams(query)...)
+	code, err = c.get(c.searchCacher, key, &data, func() (int, error) {
+		data, code, err = c.api.SearchAnime(query)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, err
+	}
+	return data, http.StatusOK, nil
 }
 
-// SearchManga to search manga (no caching).
+// SearchManga to search manga.
 func (c *Cacher) SearchManga(query model.Query) (data []model.MangaSearch, code int, err error) {
-	return c.api.SearchManga(query)
+	// Get from cache or parse.
+	key := internal.GetKey(internal.KeySearchManga, queryToParams(query)...)
+	code, err = c.get(c.searchCacher, key, &data, func() (int, error) {
+		data, code, err = c.api.SearchManga(query)
+		return code, err
+	})
+	if err != nil {
+		return nil, code, err
+	}
+	return data, http.StatusOK, nil
+}
+
+// queryToParams to convert search query to cache key params.
+// Equivalent queries will have the same params. Empty fields
+// are omitted.
+func queryToParams(query model.Query) []interface{} {
+	var params []interface{}
+	add := func(name string, value interface{}, isEmpty bool) {
+		if !isEmpty {
+			params = append(params, fmt.Sprintf("%s=%v", name, value))
+		}
+	}
+
+	title := strings.ToLower(strings.Join(strings.Fields(query.Title), " "))
+	add("q", title, title == "")
+	add("page", query.Page, query.Page <= 1)
+	add("type", query.Type, query.Type == 0)
+	add("score", query.Score, query.Score == 0)
+	add("status", query.Status, query.Status == 0)
+	add("producer", query.ProducerID, query.ProducerID == 0)
+	add("magazine", query.MagazineID, query.MagazineID == 0)
+	add("rating", query.Rating, query.Rating == 0)
+	add("start", query.StartDate.Format("2006-01-02"), query.StartDate.IsZero())
+	add("end", query.EndDate.Format("2006-01-02"), query.EndDate.IsZero())
+	add("letter", strings.ToUpper(query.FirstLetter), query.FirstLetter == "")
+
+	genres := utils.UniqueInt(query.GenreIDs)
+	sort.Ints(genres)
+	genreStr := make([]string, len(genres))
+	for i, g := range genres {
+		genreStr[i] = fmt.Sprintf("%d", g)
+	}
+	add("genre", strings.Join(genreStr, ","), len(genres) == 0)
+	add("exclude", query.ExcludeGenre, !query.ExcludeGenre || len(genres) == 0)
+
+	return params
 }
 
 // SearchCharacter to search character.
 func (c *Cacher) SearchCharacter(name string, page int) (data []model.CharacterSearch, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeySearchCharacter, name, page)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.SearchCharacter(name, page)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.SearchCharacter(name, page)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // SearchPeople to search people.
 func (c *Cacher) SearchPeople(name string, page int) (data []model.PeopleSearch, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeySearchPeople, name, page)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.SearchPeople(name, page)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.SearchPeople(name, page)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // SearchClub to search club.
 func (c *Cacher) SearchClub(query model.ClubQuery) (data []model.ClubSearch, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeySearchClub, query.Name, query.Page, query.Category, query.Sort)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.SearchClub(query)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.SearchClub(query)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
 
 // SearchUser to search user.
 func (c *Cacher) SearchUser(query model.UserQuery) (data []model.UserSearch, code int, err error) {
-	// Get from cache.
+	// Get from cache or parse.
 	key := internal.GetKey(internal.KeySearchUser, query.Username, query.Page, query.Location, query.MinAge, query.MaxAge, query.Gender)
-	if c.cacher.Get(key, &data) == nil {
-		return data, http.StatusOK, nil
-	}
-
-	// Parse.
-	data, code, err = c.api.SearchUser(query)
+	code, err = c.get(c.cacher, key, &data, func() (int, error) {
+		data, code, err = c.api.SearchUser(query)
+		return code, err
+	})
 	if err != nil {
 		return nil, code, err
 	}
-
-	// Save to cache. Won't return error.
-	_ = c.cacher.Set(key, data)
 	return data, http.StatusOK, nil
 }
@@ -3,6 +3,7 @@ package cacher
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/rl404/go-malscraper/model"
 	"github.com/rl404/go-malscraper/service/mocks"
@@ -11,27 +12,126 @@ import (
 )
 
 func TestSearchAnime(t *testing.T) {
+	var data []model.AnimeSearch
 	mockParser := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
-	mockParser.On("SearchAnime", model.Query{}).Return([]model.AnimeSearch{}, http.StatusOK, nil).Once()
-	c := Cacher{api: mockParser, cacher: mockCacher}
+	query := model.Query{Title: " Cowboy  BEBOP ", Page: 1, GenreIDs: []int{24, 1, 24}}
+	key := "mal:search-anime:q=cowboy bebop:genre=1,24"
+	t.Run("cached", func(t *testing.T) {
+		mockCacher.On("Get", key, &data).Run(func(args mock.Arguments) {
+			tmp := args.Get(1).(*[]model.AnimeSearch)
+			*tmp = []model.AnimeSearch{}
+		}).Return(nil).Once()
+		c := Cacher{api: mockParser, searchCacher: mockCacher}
+
+		d, code, err := c.SearchAnime(query)
+		assert.NotNil(t, d)
+		assert.Equal(t, code, http.StatusOK)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockParser.On("SearchAnime", query).Return(nil, http.StatusInternalServerError, errDummy).Once()
+		mockCacher.On("Get", key, &data).Return(errDummy).Once()
+		c := Cacher{api: mockParser, searchCacher: mockCacher}
+
+		d, code, err := c.SearchAnime(query)
+		assert.Nil(t, d)
+		assert.Equal(t, code, http.StatusInternalServerError)
+		assert.Error(t, err)
+		assert.EqualError(t, err, errDummy.Error())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		mockParser.On("SearchAnime", query).Return([]model.AnimeSearch{}, http.StatusOK, nil).Once()
+		mockCacher.On("Get", key, &data).Return(errDummy).Once()
+		mockCacher.On("Set", key, []model.AnimeSearch{}).Return(nil).Once()
+		c := Cacher{api: mockParser, searchCacher: mockCacher}
 
-	d, code, err := c.SearchAnime(model.Query{})
-	assert.NotNil(t, d)
-	assert.Equal(t, code, http.StatusOK)
-	assert.NoError(t, err)
+		d, code, err := c.SearchAnime(query)
+		assert.NotNil(t, d)
+		assert.Equal(t, code, http.StatusOK)
+		assert.NoError(t, err)
+	})
 }
 
 func TestSearchManga(t *testing.T) {
+	var data []model.MangaSearch
 	mockParser := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
-	mockParser.On("SearchManga", model.Query{}).Return([]model.MangaSearch{}, http.StatusOK, nil).Once()
-	c := Cacher{api: mockParser, cacher: mockCacher}
+	query := model.Query{Title: "berserk", Page: 2, Type: 1}
+	key := "mal:search-manga:q=berserk:page=2:type=1"
+	t.Run("cached", func(t *testing.T) {
+		mockCacher.On("Get", key, &data).Run(func(args mock.Arguments) {
+			tmp := args.Get(1).(*[]model.MangaSearch)
+			*tmp = []model.MangaSearch{}
+		}).Return(nil).Once()
+		c := Cacher{api: mockParser, searchCacher: mockCacher}
+
+		d, code, err := c.SearchManga(query)
+		assert.NotNil(t, d)
+		assert.Equal(t, code, http.StatusOK)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockParser.On("SearchManga", query).Return(nil, http.StatusInternalServerError, errDummy).Once()
+		mockCacher.On("Get", key, &data).Return(errDummy).Once()
+		c := Cacher{api: mockParser, searchCacher: mockCacher}
+
+		d, code, err := c.SearchManga(query)
+		assert.Nil(t, d)
+		assert.Equal(t, code, http.StatusInternalServerError)
+		assert.Error(t, err)
+		assert.EqualError(t, err, errDummy.Error())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		mockParser.On("SearchManga", query).Return([]model.MangaSearch{}, http.StatusOK, nil).Once()
+		mockCacher.On("Get", key, &data).Return(errDummy).Once()
+		mockCacher.On("Set", key, []model.MangaSearch{}).Return(nil).Once()
+		c := Cacher{api: mockParser, searchCacher: mockCacher}
+
+		d, code, err := c.SearchManga(query)
+		assert.NotNil(t, d)
+		assert.Equal(t, code, http.StatusOK)
+		assert.NoError(t, err)
+	})
+}
 
-	d, code, err := c.SearchManga(model.Query{})
-	assert.NotNil(t, d)
-	assert.Equal(t, code, http.StatusOK)
-	assert.NoError(t, err)
+func TestQueryToParams(t *testing.T) {
+	assert.Nil(t, queryToParams(model.Query{}))
+	assert.Equal(t, queryToParams(model.Query{Title: "naruto", Page: 1}), queryToParams(model.Query{Title: " NARUTO ", Page: 0}))
+	assert.Equal(t, []interface{}{
+		"q=one piece",
+		"page=3",
+		"type=1",
+		"score=7",
+		"status=2",
+		"producer=4",
+		"magazine=5",
+		"rating=3",
+		"start=2021-01-02",
+		"end=2021-03-04",
+		"letter=O",
+		"genre=1,2,3",
+		"exclude=true",
+	}, queryToParams(model.Query{
+		Title:        "One   Piece",
+		Page:         3,
+		Type:         1,
+		Score:        7,
+		Status:       2,
+		ProducerID:   4,
+		MagazineID:   5,
+		Rating:       3,
+		StartDate:    time.Date(2021, 1, 2, 15, 4, 5, 0, time.UTC),
+		EndDate:      time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC),
+		FirstLetter:  "o",
+		GenreIDs:     []int{3, 1, 2},
+		ExcludeGenre: true,
+	}))
+	assert.Equal(t, []interface{}{"q=abc"}, queryToParams(model.Query{Title: "abc", ExcludeGenre: true}))
 }
 
 func TestSearchCharacter(t *testing.T) {
@@ -0,0 +1,79 @@
+package cacher
+
+import (
+	"time"
+
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Cacher wrapper which keeps data longer than its expired
+// time. Expired data is treated as missing, unless
+// MyAnimeList is unavailable (`isStaleAllowed` returns true),
+// so callers still get the stale data instead of error.
+// The main cacher expired time should be longer than `ttl`.
+type staleCacher struct {
+	cacher         service.Cacher
+	ttl            time.Duration
+	isStaleAllowed func() bool
+	logger         service.Logger
+	observer       service.Observer
+}
+
+// NewStale to create new cacher which can return expired data
+// when `isStaleAllowed` returns true.
+func NewStale(c service.Cacher, ttl time.Duration, isStaleAllowed func() bool, l service.Logger, o service.Observer) service.Cacher {
+	return &staleCacher{
+		cacher:         c,
+		ttl:            ttl,
+		isStaleAllowed: isStaleAllowed,
+		logger:         l,
+		observer:       o,
+	}
+}
+
+// Get to get data from cache. Will return error if the
+// data is already expired and stale data is not allowed.
+func (c staleCacher) Get(key string, data interface{}) error {
+	d := ttlData{Data: data}
+	if err := c.cacher.Get(key, &d); err != nil {
+		return err
+	}
+	if timeNow().Before(d.ExpiredAt) {
+		return nil
+	}
+	if !c.isStaleAllowed() {
+		return errExpired
+	}
+	c.logger.Warn("[%s] using stale cache (expired %s ago)", key, timeNow().Sub(d.ExpiredAt).Truncate(time.Second))
+	c.observer.Inc(internal.MetricStaleCacheHit, nil)
+	return nil
+}
+
+// GetExpired to get data from cache even if it is already
+// expired and the time the data was cached.
+func (c staleCacher) GetExpired(key string, data interface{}) (time.Time, error) {
+	d := ttlData{Data: data}
+	if err := c.cacher.Get(key, &d); err != nil {
+		return time.Time{}, err
+	}
+	return d.ExpiredAt.Add(-c.ttl), nil
+}
+
+// Set to save data to cache with expired time.
+func (c staleCacher) Set(key string, data interface{}) error {
+	return c.cacher.Set(key, ttlData{
+		ExpiredAt: timeNow().Add(c.ttl),
+		Data:      data,
+	})
+}
+
+// Delete to delete data in cache.
+func (c staleCacher) Delete(key string) error {
+	return c.cacher.Delete(key)
+}
+
+// Close to close cache connection.
+func (c staleCacher) Close() error {
+	return c.cacher.Close()
+}
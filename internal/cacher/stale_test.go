@@ -0,0 +1,111 @@
+package cacher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStaleCacher(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	allowed := false
+	mockCacher := new(mocks.Cacher)
+	mockObserver := new(mocks.Observer)
+	c := NewStale(mockCacher, time.Hour, func() bool { return allowed }, mallogger.New(0, false), mockObserver)
+
+	getExpired := func(args mock.Arguments) {
+		d := args.Get(1).(*ttlData)
+		d.ExpiredAt = now.Add(-time.Minute)
+		*d.Data.(*string) = "stale"
+	}
+
+	t.Run("get-error", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Return(errDummy).Once()
+		assert.EqualError(t, c.Get("key", &data), errDummy.Error())
+	})
+
+	t.Run("get-ok", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Run(func(args mock.Arguments) {
+			d := args.Get(1).(*ttlData)
+			d.ExpiredAt = now.Add(time.Minute)
+			*d.Data.(*string) = "data"
+		}).Return(nil).Once()
+		assert.NoError(t, c.Get("key", &data))
+		assert.Equal(t, "data", data)
+	})
+
+	t.Run("get-expired", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Run(getExpired).Return(nil).Once()
+		assert.EqualError(t, c.Get("key", &data), errExpired.Error())
+	})
+
+	t.Run("get-stale", func(t *testing.T) {
+		allowed = true
+		defer func() { allowed = false }()
+
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Run(getExpired).Return(nil).Once()
+		mockObserver.On("Inc", "malscraper_stale_cache_hit", mock.Anything).Once()
+		assert.NoError(t, c.Get("key", &data))
+		assert.Equal(t, "stale", data)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		mockCacher.On("Set", "key", ttlData{ExpiredAt: now.Add(time.Hour), Data: "data"}).Return(nil).Once()
+		assert.NoError(t, c.Set("key", "data"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		mockCacher.On("Delete", "key").Return(nil).Once()
+		assert.NoError(t, c.Delete("key"))
+	})
+
+	t.Run("close", func(t *testing.T) {
+		mockCacher.On("Close").Return(nil).Once()
+		assert.NoError(t, c.Close())
+	})
+
+	mockCacher.AssertExpectations(t)
+	mockObserver.AssertExpectations(t)
+}
+
+func TestStaleCacherGetExpired(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockCacher := new(mocks.Cacher)
+	c := NewStale(mockCacher, time.Hour, func() bool { return false }, mallogger.New(0, false), new(mocks.Observer))
+
+	t.Run("error", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Return(errDummy).Once()
+		_, err := c.(expiredGetter).GetExpired("key", &data)
+		assert.EqualError(t, err, errDummy.Error())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Run(func(args mock.Arguments) {
+			d := args.Get(1).(*ttlData)
+			d.ExpiredAt = now.Add(-time.Minute)
+			*d.Data.(*string) = "stale"
+		}).Return(nil).Once()
+		cachedAt, err := c.(expiredGetter).GetExpired("key", &data)
+		assert.NoError(t, err)
+		assert.Equal(t, now.Add(-time.Minute-time.Hour), cachedAt)
+		assert.Equal(t, "stale", data)
+	})
+
+	mockCacher.AssertExpectations(t)
+}
@@ -0,0 +1,72 @@
+package cacher
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rl404/go-malscraper/service"
+)
+
+var errExpired = errors.New("cache expired")
+
+// Testable time now func.
+var timeNow = time.Now
+
+// Cacher wrapper which has its own expired time
+// regardless of the main cacher expired time. Can only be
+// shorter than the main cacher expired time.
+type ttlCacher struct {
+	cacher service.Cacher
+	ttl    time.Duration
+}
+
+type ttlData struct {
+	ExpiredAt time.Time   `json:"expiredAt"`
+	Data      interface{} `json:"data"`
+}
+
+func newTTLCacher(c service.Cacher, ttl time.Duration) service.Cacher {
+	return &ttlCacher{
+		cacher: c,
+		ttl:    ttl,
+	}
+}
+
+// Get to get data from cache. Will return error
+// if the data is already expired.
+func (c ttlCacher) Get(key string, data interface{}) error {
+	d := ttlData{Data: data}
+	if err := c.cacher.Get(key, &d); err != nil {
+		return err
+	}
+	if !timeNow().Before(d.ExpiredAt) {
+		return errExpired
+	}
+	return nil
+}
+
+// GetExpired to get data from cache even if it is already
+// expired. Only works if the main cacher also keeps the
+// expired data.
+func (c ttlCacher) GetExpired(key string, data interface{}) (time.Time, error) {
+	d := ttlData{Data: data}
+	return getExpired(c.cacher, key, &d)
+}
+
+// Set to save data to cache with expired time.
+func (c ttlCacher) Set(key string, data interface{}) error {
+	return c.cacher.Set(key, ttlData{
+		ExpiredAt: timeNow().Add(c.ttl),
+		Data:      data,
+	})
+}
+
+// Delete to delete data in cache.
+func (c ttlCacher) Delete(key string) error {
+	return c.cacher.Delete(key)
+}
+
+// Close to close cache connection.
+func (c ttlCacher) Close() error {
+	return c.cacher.Close()
+}
@@ -0,0 +1,59 @@
+package cacher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTTLCacher(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockCacher := new(mocks.Cacher)
+	c := newTTLCacher(mockCacher, time.Hour)
+
+	t.Run("get-error", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Return(errDummy).Once()
+		assert.EqualError(t, c.Get("key", &data), errDummy.Error())
+	})
+
+	t.Run("get-expired", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).(*ttlData).ExpiredAt = now
+		}).Return(nil).Once()
+		assert.EqualError(t, c.Get("key", &data), errExpired.Error())
+	})
+
+	t.Run("get-ok", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "key", mock.Anything).Run(func(args mock.Arguments) {
+			d := args.Get(1).(*ttlData)
+			d.ExpiredAt = now.Add(time.Minute)
+			*d.Data.(*string) = "data"
+		}).Return(nil).Once()
+		assert.NoError(t, c.Get("key", &data))
+		assert.Equal(t, "data", data)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		mockCacher.On("Set", "key", ttlData{ExpiredAt: now.Add(time.Hour), Data: "data"}).Return(nil).Once()
+		assert.NoError(t, c.Set("key", "data"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		mockCacher.On("Delete", "key").Return(nil).Once()
+		assert.NoError(t, c.Delete("key"))
+	})
+
+	t.Run("close", func(t *testing.T) {
+		mockCacher.On("Close").Return(nil).Once()
+		assert.NoError(t, c.Close())
+	})
+}
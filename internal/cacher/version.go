@@ -0,0 +1,60 @@
+package cacher
+
+import (
+	"errors"
+
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/service"
+)
+
+var errVersion = errors.New("cache version mismatch")
+
+// Cacher wrapper which stamps cached data with its key's
+// version (see `internal.GetVersion`). Data cached with
+// different version, including data cached before versioning
+// exists, will be treated as missing.
+type versionCacher struct {
+	cacher service.Cacher
+}
+
+type versionData struct {
+	Version int         `json:"version"`
+	Data    interface{} `json:"data"`
+}
+
+// NewVersion to create new cacher wrapper which stamps
+// cached data with version.
+func NewVersion(c service.Cacher) service.Cacher {
+	return &versionCacher{cacher: c}
+}
+
+// Get to get data from cache. Will return error if
+// the data version is different.
+func (c versionCacher) Get(key string, data interface{}) error {
+	d := versionData{Data: data}
+	if err := c.cacher.Get(key, &d); err != nil {
+		return err
+	}
+	if d.Version != internal.GetVersion(key) {
+		return errVersion
+	}
+	return nil
+}
+
+// Set to save data to cache with version.
+func (c versionCacher) Set(key string, data interface{}) error {
+	return c.cacher.Set(key, versionData{
+		Version: internal.GetVersion(key),
+		Data:    data,
+	})
+}
+
+// Delete to delete data in cache.
+func (c versionCacher) Delete(key string) error {
+	return c.cacher.Delete(key)
+}
+
+// Close to close cache connection.
+func (c versionCacher) Close() error {
+	return c.cacher.Close()
+}
@@ -0,0 +1,54 @@
+package cacher
+
+import (
+	"testing"
+
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVersionCacher(t *testing.T) {
+	mockCacher := new(mocks.Cacher)
+	c := NewVersion(mockCacher)
+
+	t.Run("get-error", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "mal:anime:1", mock.Anything).Return(errDummy).Once()
+		assert.EqualError(t, c.Get("mal:anime:1", &data), errDummy.Error())
+	})
+
+	t.Run("get-mismatch", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "mal:anime:1", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).(*versionData).Version = 0
+		}).Return(nil).Once()
+		assert.EqualError(t, c.Get("mal:anime:1", &data), errVersion.Error())
+	})
+
+	t.Run("get-ok", func(t *testing.T) {
+		var data string
+		mockCacher.On("Get", "mal:anime:1", mock.Anything).Run(func(args mock.Arguments) {
+			d := args.Get(1).(*versionData)
+			d.Version = 2
+			*d.Data.(*string) = "data"
+		}).Return(nil).Once()
+		assert.NoError(t, c.Get("mal:anime:1", &data))
+		assert.Equal(t, "data", data)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		mockCacher.On("Set", "mal:anime:1", versionData{Version: 2, Data: "data"}).Return(nil).Once()
+		assert.NoError(t, c.Set("mal:anime:1", "data"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		mockCacher.On("Delete", "mal:anime:1").Return(nil).Once()
+		assert.NoError(t, c.Delete("mal:anime:1"))
+	})
+
+	t.Run("close", func(t *testing.T) {
+		mockCacher.On("Close").Return(nil).Once()
+		assert.NoError(t, c.Close())
+	})
+}
@@ -0,0 +1,128 @@
+// Package graphql contains GraphQL schema and HTTP handler
+// which resolve the fields using malscraper API.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/rl404/go-malscraper/service"
+)
+
+// GraphQL is GraphQL HTTP handler.
+type GraphQL struct {
+	api       service.API
+	logger    service.Logger
+	schema    gql.Schema
+	apiFields map[string]bool
+	maxDepth  int
+	maxCost   int
+}
+
+// Config is GraphQL query limit config.
+type Config struct {
+	// Maximum nested fields. Default is 8.
+	MaxDepth int
+	// Maximum estimated API calls. Fields inside a list are
+	// assumed to be called for 10 items. Default is 200.
+	MaxCost int
+}
+
+// New to create new GraphQL handler. Schema is generated
+// from models and resolved using the api.
+func New(api service.API, logger service.Logger, cfg Config) (*GraphQL, error) {
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = defaultMaxDepth
+	}
+	if cfg.MaxCost <= 0 {
+		cfg.MaxCost = defaultMaxCost
+	}
+
+	g := &GraphQL{
+		api:      api,
+		logger:   logger,
+		maxDepth: cfg.MaxDepth,
+		maxCost:  cfg.MaxCost,
+	}
+
+	b := newBuilder()
+	g.registerTypes(b)
+
+	fields := g.queryFields(b)
+	for name := range fields {
+		b.apiFields["Query."+name] = true
+	}
+
+	schema, err := gql.NewSchema(gql.SchemaConfig{
+		Query: gql.NewObject(gql.ObjectConfig{
+			Name:   "Query",
+			Fields: fields,
+		}),
+	})
+	if err != nil {
+		logger.Error("failed generating graphql schema: %s", err.Error())
+		return nil, err
+	}
+
+	g.schema = schema
+	g.apiFields = b.apiFields
+	return g, nil
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// ServeHTTP to handle GraphQL request. Accept GET request with
+// `query` param and POST request with JSON body.
+func (g *GraphQL) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if v := r.URL.Query().Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				http.Error(w, "invalid variables", http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	result := g.Do(r.Context(), req.Query, req.OperationName, req.Variables)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		g.logger.Error("failed encoding graphql response: %s", err.Error())
+	}
+}
+
+// Do to execute GraphQL query. Same API calls in a single
+// query will only be called once. Query deeper or costs
+// more than the limit is rejected without calling the API.
+func (g *GraphQL) Do(ctx context.Context, query, operationName string, variables map[string]interface{}) *gql.Result {
+	if err := g.checkLimit(query, operationName); err != nil {
+		return &gql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(err)}}
+	}
+
+	return gql.Do(gql.Params{
+		Schema:         g.schema,
+		RequestString:  query,
+		OperationName:  operationName,
+		VariableValues: variables,
+		Context:        withLoader(ctx),
+	})
+}
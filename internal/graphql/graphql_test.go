@@ -0,0 +1,213 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	e "errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errDummy = e.New("dummy error")
+
+func newTest(t *testing.T) (*GraphQL, *mocks.API) {
+	api := new(mocks.API)
+	g, err := New(api, new(mocks.Logger), Config{})
+	require.NoError(t, err)
+	return g, api
+}
+
+func TestNew(t *testing.T) {
+	g, _ := newTest(t)
+	assert.NotNil(t, g.schema.QueryType())
+
+	for _, name := range []string{"Anime", "Manga", "Character", "People", "Role", "AnimeRole", "MangaRole", "CharacterRole", "User", "Club"} {
+		assert.NotNil(t, g.schema.Type(name), name)
+	}
+}
+
+func TestDo(t *testing.T) {
+	t.Run("nested", func(t *testing.T) {
+		g, api := newTest(t)
+		api.On("GetAnime", 1).Return(&model.Anime{ID: 1, Title: "Cowboy Bebop", Type: model.AnimeTypeTV}, http.StatusOK, nil).Once()
+		api.On("GetAnimeCharacter", 1).Return([]model.CharacterItem{
+			{ID: 1, Name: "Spike", VoiceActors: []model.Role{{ID: 11, Name: "Yamadera"}}},
+			{ID: 2, Name: "Faye", VoiceActors: []model.Role{{ID: 11, Name: "Yamadera"}}},
+		}, http.StatusOK, nil).Once()
+		api.On("GetPeople", 11).Return(&model.People{ID: 11, Name: "Yamadera Kouichi"}, http.StatusOK, nil).Once()
+
+		result := g.Do(context.Background(), `{
+			anime(id: 1) {
+				title
+				type
+				characters {
+					name
+					voiceActors { person { name } }
+				}
+			}
+		}`, "", nil)
+		require.Empty(t, result.Errors)
+
+		j, _ := json.Marshal(result.Data)
+		assert.JSONEq(t, `{"anime":{"title":"Cowboy Bebop","type":"TV","characters":[
+			{"name":"Spike","voiceActors":[{"person":{"name":"Yamadera Kouichi"}}]},
+			{"name":"Faye","voiceActors":[{"person":{"name":"Yamadera Kouichi"}}]}
+		]}}`, string(j))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("args", func(t *testing.T) {
+		g, api := newTest(t)
+		api.On("GetAnime", 1).Return(&model.Anime{ID: 1}, http.StatusOK, nil).Once()
+		api.On("GetAnimeReview", 1, 1).Return([]model.Review{{ID: 1}}, http.StatusOK, nil).Once()
+		api.On("GetAnimeReview", 1, 2).Return([]model.Review{{ID: 2}}, http.StatusOK, nil).Once()
+
+		result := g.Do(context.Background(), `{
+			anime(id: 1) {
+				a: reviews { id }
+				b: reviews(page: 1) { id }
+				c: reviews(page: 2) { id }
+			}
+		}`, "", nil)
+		require.Empty(t, result.Errors)
+
+		j, _ := json.Marshal(result.Data)
+		assert.JSONEq(t, `{"anime":{"a":[{"id":1}],"b":[{"id":1}],"c":[{"id":2}]}}`, string(j))
+		api.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		g, api := newTest(t)
+		api.On("GetManga", 1).Return(nil, http.StatusNotFound, errDummy)
+
+		result := g.Do(context.Background(), `{ manga(id: 1) { title } }`, "", nil)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, errDummy.Error(), result.Errors[0].Message)
+	})
+}
+
+func TestServeHTTP(t *testing.T) {
+	g, api := newTest(t)
+	api.On("GetUser", "rl404").Return(&model.User{Username: "rl404"}, http.StatusOK, nil)
+
+	t.Run("get", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, `/?query={user(username:"rl404"){username}}`, nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data":{"user":{"username":"rl404"}}}`, w.Body.String())
+	})
+
+	t.Run("post", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		body := `{"query":"query($u: String!){user(username:$u){username}}","variables":{"u":"rl404"}}`
+		g.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data":{"user":{"username":"rl404"}}}`, w.Body.String())
+	})
+
+	t.Run("invalid-body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("method", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+func TestLimit(t *testing.T) {
+	g, api := newTest(t)
+
+	tests := []struct {
+		name  string
+		query string
+		err   string
+	}{
+		{name: "ok", query: `{ anime(id: 1) { characters { voiceActors { person { name } } } } }`},
+		{name: "introspection", query: `{ __schema { types { fields { type { ofType { ofType { ofType { ofType { name } } } } } } } } }`},
+		{name: "depth", query: `{ anime(id: 1) { characters { voiceActors { person { characters { anime { anime { characters { name } } } } } } } } }`, err: "query depth 9 exceeds limit 8"},
+		{name: "cost", query: `{ anime(id: 1) { characters { voiceActors { person { characters { anime { title } } } } } } }`, err: "query cost 201 exceeds limit 200"},
+		{name: "fragment", query: `
+			query { anime(id: 1) { ...chars } }
+			fragment chars on Anime { characters { voiceActors { person { ...roles } } } }
+			fragment roles on People { characters { anime { title } } }`, err: "query cost 201 exceeds limit 200"},
+		{name: "cyclic-fragment", query: `{ anime(id: 1) { ...a } } fragment a on Anime { ...a }`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := g.checkLimit(test.query, "")
+			if test.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.err)
+		})
+	}
+
+	t.Run("do", func(t *testing.T) {
+		result := g.Do(context.Background(), tests[2].query, "", nil)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, tests[2].err, result.Errors[0].Message)
+		api.AssertNotCalled(t, "GetAnime", 1)
+	})
+}
+
+func TestLoader(t *testing.T) {
+	t.Run("key", func(t *testing.T) {
+		l := newLoader()
+		fn := func(data string) func() (interface{}, int, error) {
+			return func() (interface{}, int, error) { return data, http.StatusOK, nil }
+		}
+
+		data, _ := l.load("method", []interface{}{"ab", "c"}, fn("1"))
+		assert.Equal(t, "1", data)
+		data, _ = l.load("method", []interface{}{"a", "bc"}, fn("2"))
+		assert.Equal(t, "2", data)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		l := newLoader()
+		started, done := make(chan struct{}), make(chan error)
+
+		go func() {
+			defer func() { recover() }()
+			l.load("method", nil, func() (interface{}, int, error) {
+				close(started)
+				time.Sleep(10 * time.Millisecond)
+				panic("dummy")
+			})
+		}()
+
+		<-started
+		go func() {
+			_, err := l.load("method", nil, func() (interface{}, int, error) { return nil, http.StatusOK, nil })
+			done <- err
+		}()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				assert.Equal(t, errLoadPanic, err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("waiter is blocked")
+		}
+
+		// Call is retried after panic.
+		data, err := l.load("method", nil, func() (interface{}, int, error) { return "ok", http.StatusOK, nil })
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", data)
+	})
+}
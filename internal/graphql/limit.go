@@ -0,0 +1,162 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// Default query limits.
+const (
+	defaultMaxDepth = 8
+	defaultMaxCost  = 200
+
+	// Assumed number of items in a list to estimate
+	// API calls of the fields inside the list.
+	listSize = 10
+)
+
+// LimitError is returned if query is deeper or costs more
+// than the limit.
+type LimitError struct {
+	Name  string
+	Value int
+	Limit int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("query %s %d exceeds limit %d", e.Name, e.Value, e.Limit)
+}
+
+// limiter calculates query depth and cost (estimated
+// API calls) before the query is executed.
+type limiter struct {
+	schema    gql.Schema
+	apiFields map[string]bool
+	fragments map[string]*ast.FragmentDefinition
+	visiting  map[string]bool
+	maxCost   int
+}
+
+// checkLimit to check query depth and cost. Invalid query
+// is ignored because it will be rejected when executed.
+func (g *GraphQL) checkLimit(query, operationName string) error {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return nil
+	}
+
+	l := &limiter{
+		schema:    g.schema,
+		apiFields: g.apiFields,
+		fragments: make(map[string]*ast.FragmentDefinition),
+		visiting:  make(map[string]bool),
+		maxCost:   g.maxCost,
+	}
+
+	var ops []*ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.FragmentDefinition:
+			l.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			ops = append(ops, d)
+		}
+	}
+
+	for _, op := range ops {
+		if operationName != "" && (op.Name == nil || op.Name.Value != operationName) {
+			continue
+		}
+
+		depth, cost := l.selectionSet(op.SelectionSet, g.schema.QueryType(), 1)
+		if depth > g.maxDepth {
+			return &LimitError{Name: "depth", Value: depth, Limit: g.maxDepth}
+		}
+		if cost > g.maxCost {
+			return &LimitError{Name: "cost", Value: cost, Limit: g.maxCost}
+		}
+	}
+
+	return nil
+}
+
+// selectionSet to get the selection set's depth and cost. Every API
+// field costs `multiplier` which is multiplied by `listSize` for each
+// list the field is in.
+func (l *limiter) selectionSet(set *ast.SelectionSet, parent *gql.Object, multiplier int) (depth, cost int) {
+	if set == nil || parent == nil {
+		return 0, 0
+	}
+
+	for _, s := range set.Selections {
+		var d, c int
+		switch sel := s.(type) {
+		case *ast.Field:
+			d, c = l.field(sel, parent, multiplier)
+		case *ast.InlineFragment:
+			d, c = l.selectionSet(sel.SelectionSet, parent, multiplier)
+		case *ast.FragmentSpread:
+			name := sel.Name.Value
+			if f, ok := l.fragments[name]; ok && !l.visiting[name] {
+				l.visiting[name] = true
+				d, c = l.selectionSet(f.SelectionSet, parent, multiplier)
+				l.visiting[name] = false
+			}
+		}
+
+		if d > depth {
+			depth = d
+		}
+		cost = l.add(cost, c)
+	}
+
+	return depth, cost
+}
+
+func (l *limiter) field(f *ast.Field, parent *gql.Object, multiplier int) (depth, cost int) {
+	// Introspection doesn't call the API.
+	if strings.HasPrefix(f.Name.Value, "__") {
+		return 0, 0
+	}
+
+	def, ok := parent.Fields()[f.Name.Value]
+	if !ok {
+		return 0, 0
+	}
+
+	if l.apiFields[parent.Name()+"."+f.Name.Value] {
+		cost = multiplier
+	}
+
+	typ := def.Type
+	for {
+		switch t := typ.(type) {
+		case *gql.NonNull:
+			typ = t.OfType
+			continue
+		case *gql.List:
+			multiplier = l.add(0, multiplier*listSize)
+			typ = t.OfType
+			continue
+		}
+		break
+	}
+
+	obj, _ := typ.(*gql.Object)
+	d, c := l.selectionSet(f.SelectionSet, obj, multiplier)
+
+	return d + 1, l.add(cost, c)
+}
+
+// add to sum the cost which stops growing after exceeding
+// the limit so it won't overflow.
+func (l *limiter) add(a, b int) int {
+	if a+b > l.maxCost {
+		return l.maxCost + 1
+	}
+	return a + b
+}
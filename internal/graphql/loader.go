@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+type loaderKey struct{}
+
+// errLoadPanic is returned to waiting callers if the
+// call they are waiting for panics.
+var errLoadPanic = errors.New("graphql: loader call panicked")
+
+// loader memoizes API calls in a single request so
+// repeated lookups (e.g. the same voice actor in many
+// characters) only call the API once.
+type loader struct {
+	sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	done chan struct{}
+	data interface{}
+	err  error
+}
+
+func newLoader() *loader {
+	return &loader{calls: make(map[string]*call)}
+}
+
+func withLoader(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loaderKey{}, newLoader())
+}
+
+func getLoader(ctx context.Context) *loader {
+	if l, ok := ctx.Value(loaderKey{}).(*loader); ok {
+		return l
+	}
+	return newLoader()
+}
+
+// load to call `fn` once for each method and params combination.
+// If `fn` panics, waiting callers get `errLoadPanic` and the call
+// is forgotten so it can be retried.
+func (l *loader) load(method string, params []interface{}, fn func() (interface{}, int, error)) (interface{}, error) {
+	key := fmt.Sprintf("%s%#v", method, params)
+
+	l.Lock()
+	c, ok := l.calls[key]
+	if !ok {
+		c = &call{done: make(chan struct{})}
+		l.calls[key] = c
+	}
+	l.Unlock()
+
+	if ok {
+		<-c.done
+		return c.data, c.err
+	}
+
+	finished := false
+	defer func() {
+		if !finished {
+			c.data, c.err = nil, errLoadPanic
+			l.Lock()
+			delete(l.calls, key)
+			l.Unlock()
+		}
+		close(c.done)
+	}()
+
+	c.data, _, c.err = fn()
+	if c.err != nil {
+		c.data = nil
+	}
+	finished = true
+
+	return c.data, c.err
+}
@@ -0,0 +1,421 @@
+package graphql
+
+import (
+	"reflect"
+	"sort"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/rl404/go-malscraper/model"
+)
+
+// User list status to get all anime/manga in the list.
+const statusAll = 7
+
+var (
+	idArgs = gql.FieldConfigArgument{
+		"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Int)},
+	}
+	usernameArgs = gql.FieldConfigArgument{
+		"username": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+	}
+	pageArgs = gql.FieldConfigArgument{
+		"page": &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+	}
+	searchArgs = gql.FieldConfigArgument{
+		"query": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+		"page":  &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+	}
+	topArgs = gql.FieldConfigArgument{
+		"type": &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 0},
+		"page": &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+	}
+	listArgs = gql.FieldConfigArgument{
+		"status": &gql.ArgumentConfig{Type: gql.Int, DefaultValue: statusAll},
+		"page":   &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+	}
+)
+
+func list(b *builder, i interface{}, name ...string) gql.Output {
+	return gql.NewList(b.output(reflect.TypeOf(i), getName(name)))
+}
+
+func object(b *builder, i interface{}, name ...string) gql.Output {
+	return b.output(reflect.TypeOf(i), getName(name))
+}
+
+func getName(name []string) string {
+	if len(name) > 0 {
+		return name[0]
+	}
+	return ""
+}
+
+// resolver to create field resolver which calls the api once
+// for each method and args combination in a request.
+func resolver(method string, key func(p gql.ResolveParams) []interface{}, fn func(p gql.ResolveParams) (interface{}, int, error)) gql.FieldResolveFn {
+	return func(p gql.ResolveParams) (interface{}, error) {
+		params := key(p)
+		if params == nil {
+			return nil, nil
+		}
+
+		args := make([]string, 0, len(p.Args))
+		for k := range p.Args {
+			args = append(args, k)
+		}
+		sort.Strings(args)
+		for _, k := range args {
+			params = append(params, k, p.Args[k])
+		}
+
+		return getLoader(p.Context).load(method, params, func() (interface{}, int, error) {
+			return fn(p)
+		})
+	}
+}
+
+func argsKey(p gql.ResolveParams) []interface{} {
+	return []interface{}{}
+}
+
+func sourceIDKey(p gql.ResolveParams) []interface{} {
+	if id := getSourceField(p.Source, "ID").(int); id > 0 {
+		return []interface{}{id}
+	}
+	return nil
+}
+
+func sourceUsernameKey(p gql.ResolveParams) []interface{} {
+	if username := getSourceField(p.Source, "Username").(string); username != "" {
+		return []interface{}{username}
+	}
+	return nil
+}
+
+// getSourceField to get field value of parent object
+// by its Go field name.
+func getSourceField(src interface{}, name string) interface{} {
+	v := reflect.ValueOf(src)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v.FieldByName(name).Interface()
+}
+
+func sourceID(p gql.ResolveParams) int {
+	return getSourceField(p.Source, "ID").(int)
+}
+
+func sourceUsername(p gql.ResolveParams) string {
+	return getSourceField(p.Source, "Username").(string)
+}
+
+// rootField to create root query field resolved by args.
+func rootField(typ gql.Output, method string, args gql.FieldConfigArgument, fn func(p gql.ResolveParams) (interface{}, int, error)) *gql.Field {
+	return &gql.Field{Type: typ, Args: args, Resolve: resolver(method, argsKey, fn)}
+}
+
+// idField to create object field resolved by parent's id.
+func idField(typ gql.Output, method string, args gql.FieldConfigArgument, fn func(p gql.ResolveParams) (interface{}, int, error)) *gql.Field {
+	return &gql.Field{Type: typ, Args: args, Resolve: resolver(method, sourceIDKey, fn)}
+}
+
+// usernameField to create object field resolved by parent's username.
+func usernameField(typ gql.Output, method string, args gql.FieldConfigArgument, fn func(p gql.ResolveParams) (interface{}, int, error)) *gql.Field {
+	return &gql.Field{Type: typ, Args: args, Resolve: resolver(method, sourceUsernameKey, fn)}
+}
+
+func (g *GraphQL) queryFields(b *builder) gql.Fields {
+	a := g.api
+	return gql.Fields{
+		"anime": rootField(object(b, model.Anime{}), "GetAnime", idArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetAnime(p.Args["id"].(int))
+		}),
+		"manga": rootField(object(b, model.Manga{}), "GetManga", idArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetManga(p.Args["id"].(int))
+		}),
+		"character": rootField(object(b, model.Character{}), "GetCharacter", idArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetCharacter(p.Args["id"].(int))
+		}),
+		"people": rootField(object(b, model.People{}), "GetPeople", idArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetPeople(p.Args["id"].(int))
+		}),
+		"club": rootField(object(b, model.Club{}), "GetClub", idArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetClub(p.Args["id"].(int))
+		}),
+		"review": rootField(object(b, model.Review{}), "GetReview", idArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetReview(p.Args["id"].(int))
+		}),
+		"news": rootField(object(b, model.News{}), "GetNews", idArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetNews(p.Args["id"].(int))
+		}),
+		"article": rootField(object(b, model.Article{}), "GetArticle", idArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetArticle(p.Args["id"].(int))
+		}),
+		"user": rootField(object(b, model.User{}), "GetUser", usernameArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetUser(p.Args["username"].(string))
+		}),
+		"season": rootField(list(b, model.AnimeItem{}), "GetSeason", gql.FieldConfigArgument{
+			"season": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+			"year":   &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Int)},
+		}, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetSeason(p.Args["season"].(string), p.Args["year"].(int))
+		}),
+		"searchAnime": rootField(list(b, model.AnimeSearch{}), "SearchAnime", searchArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.SearchAnime(model.Query{Title: p.Args["query"].(string), Page: p.Args["page"].(int)})
+		}),
+		"searchManga": rootField(list(b, model.MangaSearch{}), "SearchManga", searchArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.SearchManga(model.Query{Title: p.Args["query"].(string), Page: p.Args["page"].(int)})
+		}),
+		"searchCharacter": rootField(list(b, model.CharacterSearch{}), "SearchCharacter", searchArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.SearchCharacter(p.Args["query"].(string), p.Args["page"].(int))
+		}),
+		"searchPeople": rootField(list(b, model.PeopleSearch{}), "SearchPeople", searchArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.SearchPeople(p.Args["query"].(string), p.Args["page"].(int))
+		}),
+		"topAnime": rootField(list(b, model.TopAnime{}), "GetTopAnime", topArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetTopAnime(p.Args["type"].(int), p.Args["page"].(int))
+		}),
+		"topManga": rootField(list(b, model.TopManga{}), "GetTopManga", topArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetTopManga(p.Args["type"].(int), p.Args["page"].(int))
+		}),
+		"topCharacter": rootField(list(b, model.TopCharacter{}), "GetTopCharacter", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetTopCharacter(p.Args["page"].(int))
+		}),
+		"topPeople": rootField(list(b, model.TopPeople{}), "GetTopPeople", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+			return a.GetTopPeople(p.Args["page"].(int))
+		}),
+	}
+}
+
+// registerTypes to register object type names and
+// additional fields resolved by other api.
+//
+// `model.Role` is used for different kinds of role. To make
+// its id resolvable, it is split to several objects.
+//
+//  Role           id is people id (voice actor & staff)
+//  AnimeRole      id is anime id
+//  MangaRole      id is manga id
+//  CharacterRole  id is character id
+func (g *GraphQL) registerTypes(b *builder) {
+	a := g.api
+
+	b.fieldTypes["PeopleCharacter.anime"] = "AnimeRole"
+	b.fieldTypes["PeopleCharacter.character"] = "CharacterRole"
+
+	b.extensions["Anime"] = func() gql.Fields {
+		return gql.Fields{
+			"characters": idField(list(b, model.CharacterItem{}), "GetAnimeCharacter", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeCharacter(sourceID(p))
+			}),
+			"staff": idField(list(b, model.Role{}), "GetAnimeStaff", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeStaff(sourceID(p))
+			}),
+			"videos": idField(object(b, model.Video{}), "GetAnimeVideo", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeVideo(sourceID(p), p.Args["page"].(int))
+			}),
+			"episodes": idField(list(b, model.Episode{}), "GetAnimeEpisode", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeEpisode(sourceID(p), p.Args["page"].(int))
+			}),
+			"stats": idField(object(b, model.Stats{}), "GetAnimeStats", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeStats(sourceID(p))
+			}),
+			"reviews": idField(list(b, model.Review{}), "GetAnimeReview", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeReview(sourceID(p), p.Args["page"].(int))
+			}),
+			"recommendations": idField(list(b, model.Recommendation{}), "GetAnimeRecommendation", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeRecommendation(sourceID(p))
+			}),
+			"news": idField(list(b, model.NewsItem{}), "GetAnimeNews", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeNews(sourceID(p))
+			}),
+			"articles": idField(list(b, model.ArticleItem{}), "GetAnimeArticle", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeArticle(sourceID(p))
+			}),
+			"clubs": idField(list(b, model.ClubItem{}), "GetAnimeClub", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeClub(sourceID(p))
+			}),
+			"pictures": idField(gql.NewList(gql.String), "GetAnimePicture", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimePicture(sourceID(p))
+			}),
+			"moreInfo": idField(gql.String, "GetAnimeMoreInfo", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnimeMoreInfo(sourceID(p))
+			}),
+		}
+	}
+
+	b.extensions["Manga"] = func() gql.Fields {
+		return gql.Fields{
+			"characters": idField(list(b, model.Role{}, "CharacterRole"), "GetMangaCharacter", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaCharacter(sourceID(p))
+			}),
+			"stats": idField(object(b, model.Stats{}), "GetMangaStats", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaStats(sourceID(p))
+			}),
+			"reviews": idField(list(b, model.Review{}), "GetMangaReview", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaReview(sourceID(p), p.Args["page"].(int))
+			}),
+			"recommendations": idField(list(b, model.Recommendation{}), "GetMangaRecommendation", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaRecommendation(sourceID(p))
+			}),
+			"news": idField(list(b, model.NewsItem{}), "GetMangaNews", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaNews(sourceID(p))
+			}),
+			"articles": idField(list(b, model.ArticleItem{}), "GetMangaArticle", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaArticle(sourceID(p))
+			}),
+			"clubs": idField(list(b, model.ClubItem{}), "GetMangaClub", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaClub(sourceID(p))
+			}),
+			"pictures": idField(gql.NewList(gql.String), "GetMangaPicture", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaPicture(sourceID(p))
+			}),
+			"moreInfo": idField(gql.String, "GetMangaMoreInfo", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetMangaMoreInfo(sourceID(p))
+			}),
+		}
+	}
+
+	b.extensions["Character"] = func() gql.Fields {
+		return gql.Fields{
+			"anime": idField(list(b, model.Role{}, "AnimeRole"), "GetCharacterAnime", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetCharacterOgraphy("anime", sourceID(p))
+			}),
+			"manga": idField(list(b, model.Role{}, "MangaRole"), "GetCharacterManga", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetCharacterOgraphy("manga", sourceID(p))
+			}),
+			"voiceActors": idField(list(b, model.Role{}), "GetCharacterVA", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetCharacterVA(sourceID(p))
+			}),
+			"articles": idField(list(b, model.ArticleItem{}), "GetCharacterArticle", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetCharacterArticle(sourceID(p))
+			}),
+			"pictures": idField(gql.NewList(gql.String), "GetCharacterPicture", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetCharacterPicture(sourceID(p))
+			}),
+			"clubs": idField(list(b, model.ClubItem{}), "GetCharacterClub", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetCharacterClub(sourceID(p))
+			}),
+		}
+	}
+
+	b.extensions["People"] = func() gql.Fields {
+		return gql.Fields{
+			"characters": idField(list(b, model.PeopleCharacter{}), "GetPeopleCharacter", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetPeopleCharacter(sourceID(p))
+			}),
+			"staff": idField(list(b, model.Role{}, "AnimeRole"), "GetPeopleStaff", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetPeopleStaff(sourceID(p))
+			}),
+			"manga": idField(list(b, model.Role{}, "MangaRole"), "GetPeopleManga", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetPeopleManga(sourceID(p))
+			}),
+			"news": idField(list(b, model.NewsItem{}), "GetPeopleNews", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetPeopleNews(sourceID(p))
+			}),
+			"articles": idField(list(b, model.ArticleItem{}), "GetPeopleArticle", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetPeopleArticle(sourceID(p))
+			}),
+			"pictures": idField(gql.NewList(gql.String), "GetPeoplePicture", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetPeoplePicture(sourceID(p))
+			}),
+		}
+	}
+
+	b.extensions["Club"] = func() gql.Fields {
+		return gql.Fields{
+			"members": idField(list(b, model.ClubMember{}), "GetClubMember", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetClubMember(sourceID(p), p.Args["page"].(int))
+			}),
+			"pictures": idField(gql.NewList(gql.String), "GetClubPicture", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetClubPicture(sourceID(p))
+			}),
+			"related": idField(object(b, model.ClubRelated{}), "GetClubRelated", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetClubRelated(sourceID(p))
+			}),
+		}
+	}
+
+	b.extensions["User"] = func() gql.Fields {
+		return gql.Fields{
+			"stats": usernameField(object(b, model.UserStats{}), "GetUserStats", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUserStats(sourceUsername(p))
+			}),
+			"favorites": usernameField(object(b, model.UserFavorite{}), "GetUserFavorite", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUserFavorite(sourceUsername(p))
+			}),
+			"friends": usernameField(list(b, model.UserFriend{}), "GetUserFriend", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUserFriend(sourceUsername(p), p.Args["page"].(int))
+			}),
+			"reviews": usernameField(list(b, model.Review{}), "GetUserReview", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUserReview(sourceUsername(p), p.Args["page"].(int))
+			}),
+			"recommendations": usernameField(list(b, model.Recommendation{}), "GetUserRecommendation", pageArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUserRecommendation(sourceUsername(p), p.Args["page"].(int))
+			}),
+			"clubs": usernameField(list(b, model.Item{}), "GetUserClub", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUserClub(sourceUsername(p))
+			}),
+			"animeList": usernameField(list(b, model.UserAnime{}), "GetUserAnime", listArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUserAnime(model.UserListQuery{Username: sourceUsername(p), Status: p.Args["status"].(int), Page: p.Args["page"].(int)})
+			}),
+			"mangaList": usernameField(list(b, model.UserManga{}), "GetUserManga", listArgs, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUserManga(model.UserListQuery{Username: sourceUsername(p), Status: p.Args["status"].(int), Page: p.Args["page"].(int)})
+			}),
+		}
+	}
+
+	// Links to details.
+	animeDetail := func() gql.Fields {
+		return gql.Fields{
+			"anime": idField(object(b, model.Anime{}), "GetAnime", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetAnime(sourceID(p))
+			}),
+		}
+	}
+	mangaDetail := func() gql.Fields {
+		return gql.Fields{
+			"manga": idField(object(b, model.Manga{}), "GetManga", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetManga(sourceID(p))
+			}),
+		}
+	}
+	characterDetail := func() gql.Fields {
+		return gql.Fields{
+			"character": idField(object(b, model.Character{}), "GetCharacter", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetCharacter(sourceID(p))
+			}),
+		}
+	}
+	peopleDetail := func() gql.Fields {
+		return gql.Fields{
+			"person": idField(object(b, model.People{}), "GetPeople", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetPeople(sourceID(p))
+			}),
+		}
+	}
+	userDetail := func() gql.Fields {
+		return gql.Fields{
+			"user": usernameField(object(b, model.User{}), "GetUser", nil, func(p gql.ResolveParams) (interface{}, int, error) {
+				return a.GetUser(sourceUsername(p))
+			}),
+		}
+	}
+
+	for _, name := range []string{"AnimeRole", "AnimeItem", "AnimeSearch", "TopAnime", "UserAnime"} {
+		b.extensions[name] = animeDetail
+	}
+	for _, name := range []string{"MangaRole", "MangaItem", "MangaSearch", "TopManga", "UserManga"} {
+		b.extensions[name] = mangaDetail
+	}
+	for _, name := range []string{"CharacterRole", "CharacterItem", "CharacterSearch", "TopCharacter"} {
+		b.extensions[name] = characterDetail
+	}
+	for _, name := range []string{"Role", "PeopleSearch", "TopPeople"} {
+		b.extensions[name] = peopleDetail
+	}
+	for _, name := range []string{"UserFriend", "ClubMember"} {
+		b.extensions[name] = userDetail
+	}
+}
@@ -0,0 +1,173 @@
+package graphql
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	gql "github.com/graphql-go/graphql"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	nameRegex    = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+)
+
+// builder generates GraphQL object types from model structs
+// using their JSON field names.
+type builder struct {
+	objects map[string]*gql.Object
+
+	// Object name for struct field type if it should be
+	// different from its Go type name. Key is `Object.field`.
+	fieldTypes map[string]string
+
+	// Additional fields for each object which usually
+	// resolved by calling other API.
+	extensions map[string]func() gql.Fields
+
+	// Fields resolved by calling API. Key is `Object.field`.
+	apiFields map[string]bool
+}
+
+func newBuilder() *builder {
+	return &builder{
+		objects:    make(map[string]*gql.Object),
+		fieldTypes: make(map[string]string),
+		extensions: make(map[string]func() gql.Fields),
+		apiFields:  make(map[string]bool),
+	}
+}
+
+// output to get GraphQL output type of a Go type. Object name will be
+// the Go type name if `name` is empty. Returns nil if not supported.
+func (b *builder) output(t reflect.Type, name string) gql.Output {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType || (t.Implements(stringerType) && t.Kind() != reflect.Struct) {
+		return gql.String
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return gql.Boolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return gql.Int
+	case reflect.Float32, reflect.Float64:
+		return gql.Float
+	case reflect.String:
+		return gql.String
+	case reflect.Slice, reflect.Array:
+		elem := b.output(t.Elem(), name)
+		if elem == nil {
+			return nil
+		}
+		return gql.NewList(elem)
+	case reflect.Struct:
+		if name == "" {
+			name = t.Name()
+		}
+		return b.object(t, name)
+	default:
+		return nil
+	}
+}
+
+// object to get (or generate) GraphQL object of a struct type.
+func (b *builder) object(t reflect.Type, name string) *gql.Object {
+	if obj, ok := b.objects[name]; ok {
+		return obj
+	}
+
+	obj := gql.NewObject(gql.ObjectConfig{
+		Name: name,
+		Fields: gql.FieldsThunk(func() gql.Fields {
+			fields := b.structFields(t, name)
+			if ext, ok := b.extensions[name]; ok {
+				for k, f := range ext() {
+					fields[k] = f
+					b.apiFields[name+"."+k] = true
+				}
+			}
+			return fields
+		}),
+	})
+	b.objects[name] = obj
+
+	return obj
+}
+
+func (b *builder) structFields(t reflect.Type, objName string) gql.Fields {
+	fields := gql.Fields{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		// Use Go field name if JSON name is not a valid
+		// GraphQL name (e.g. `10` in score stats).
+		if !nameRegex.MatchString(name) {
+			name = f.Name
+		}
+
+		typ := b.output(f.Type, b.fieldTypes[objName+"."+name])
+		if typ == nil {
+			continue
+		}
+
+		fields[name] = &gql.Field{
+			Type:    typ,
+			Resolve: fieldResolver(i),
+		}
+	}
+	return fields
+}
+
+// fieldResolver to resolve struct field by its index.
+func fieldResolver(i int) gql.FieldResolveFn {
+	return func(p gql.ResolveParams) (interface{}, error) {
+		v := reflect.ValueOf(p.Source)
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return nil, nil
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return nil, nil
+		}
+		return toOutput(v.Field(i)), nil
+	}
+}
+
+func toOutput(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+
+	switch i := v.Interface().(type) {
+	case time.Time:
+		if i.IsZero() {
+			return nil
+		}
+		return i.Format(time.RFC3339)
+	case fmt.Stringer:
+		if v.Kind() != reflect.Struct {
+			return i.String()
+		}
+	}
+
+	return v.Interface()
+}
@@ -64,6 +64,8 @@ const (
 	KeyUserClub            = "mal:user-club"
 	KeyUserAnime           = "mal:user-anime"
 	KeyUserManga           = "mal:user-manga"
+	KeySearchAnime         = "mal:search-anime"
+	KeySearchManga         = "mal:search-manga"
 	KeySearchCharacter     = "mal:search-character"
 	KeySearchPeople        = "mal:search-people"
 	KeySearchUser          = "mal:search-user"
@@ -93,8 +95,96 @@ const (
 	KeyEmptyNews           = "mal:empty:news"
 	KeyEmptyReview         = "mal:empty:review"
 	KeyEmptyUser           = "mal:empty:user"
+	KeyHTTPValidator       = "mal:http-validator"
+	KeyPage                = "mal:page"
+	KeyPageIndex           = "mal:page-index"
 )
 
+// List of cached data version of each key. Bump the version
+// whenever the model or parser of the key changes so data
+// cached by the older version will be treated as missing.
+var keyVersions = map[string]int{
+	KeyAnime:               2,
+	KeyAnimeVideo:          1,
+	KeyAnimeEpisode:        1,
+	KeyAnimeReview:         1,
+	KeyAnimeRecommendation: 1,
+	KeyAnimeStats:          1,
+	KeyAnimeCharacter:      1,
+	KeyAnimeStaff:          1,
+	KeyAnimeNews:           1,
+	KeyAnimeArticle:        1,
+	KeyAnimeClub:           1,
+	KeyAnimePicture:        1,
+	KeyAnimeMoreInfo:       1,
+	KeyManga:               2,
+	KeyMangaReview:         1,
+	KeyMangaRecommendation: 1,
+	KeyMangaStats:          1,
+	KeyMangaCharacter:      1,
+	KeyMangaNews:           1,
+	KeyMangaArticle:        1,
+	KeyMangaClub:           1,
+	KeyMangaPicture:        1,
+	KeyMangaMoreInfo:       1,
+	KeyCharacter:           1,
+	KeyCharacterArticle:    1,
+	KeyCharacterClub:       1,
+	KeyCharacterPicture:    1,
+	KeyCharacterOgraphy:    1,
+	KeyCharacterVA:         1,
+	KeyPeople:              1,
+	KeyPeopleNews:          1,
+	KeyPeopleArticle:       1,
+	KeyPeoplePicture:       1,
+	KeyPeopleChar:          1,
+	KeyPeopleStaff:         1,
+	KeyPeopleManga:         1,
+	KeyProducers:           1,
+	KeyProducer:            3,
+	KeyMagazines:           1,
+	KeyMagazine:            1,
+	KeyGenres:              1,
+	KeyAnimeWithGenre:      3,
+	KeyMangaWithGenre:      1,
+	KeyReviews:             1,
+	KeyReview:              1,
+	KeyRecommendations:     1,
+	KeyRecommendation:      1,
+	KeyUser:                1,
+	KeyUserStats:           1,
+	KeyUserFavorite:        1,
+	KeyUserFriend:          1,
+	KeyUserHistory:         1,
+	KeyUserReview:          1,
+	KeyUserRecommendation:  1,
+	KeyUserClub:            1,
+	KeyUserAnime:           2,
+	KeyUserManga:           1,
+	KeySearchAnime:         1,
+	KeySearchManga:         1,
+	KeySearchCharacter:     1,
+	KeySearchPeople:        1,
+	KeySearchUser:          1,
+	KeySearchClub:          1,
+	KeySeason:              3,
+	KeyTopAnime:            2,
+	KeyTopManga:            1,
+	KeyTopCharacter:        1,
+	KeyTopPeople:           1,
+	KeyNewsList:            1,
+	KeyNews:                1,
+	KeyNewsTag:             1,
+	KeyArticle:             1,
+	KeyArticleTag:          1,
+	KeyArticleList:         1,
+	KeyClubs:               1,
+	KeyClub:                1,
+	KeyClubMember:          1,
+	KeyClubPicture:         1,
+	KeyClubRelated:         1,
+}
+
 func GetKey(key string, params ...interface{}) string {
 	strParams := []string{key}
 	for _, p := range params {
@@ -102,3 +192,18 @@ func GetKey(key string, params ...interface{}) string {
 	}
 	return strings.Join(strParams, ":")
 }
+
+// GetVersion to get cached data version of the key. Key
+// without registered version is version 1.
+func GetVersion(key string) int {
+	for {
+		if v, ok := keyVersions[key]; ok {
+			return v
+		}
+		i := strings.LastIndex(key, ":")
+		if i < 0 {
+			return 1
+		}
+		key = key[:i]
+	}
+}
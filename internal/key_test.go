@@ -0,0 +1,23 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetKey(t *testing.T) {
+	assert.Equal(t, "mal:anime", GetKey(KeyAnime))
+	assert.Equal(t, "mal:anime:1:2", GetKey(KeyAnime, 1, "2"))
+}
+
+func TestGetVersion(t *testing.T) {
+	keyVersions[KeyAnimeVideo] = 2
+	defer func() { keyVersions[KeyAnimeVideo] = 1 }()
+
+	assert.Equal(t, 1, GetVersion(GetKey(KeyAnimeStats, 1)))
+	assert.Equal(t, 2, GetVersion(GetKey(KeyAnimeVideo, 1, 2)))
+	assert.Equal(t, 1, GetVersion(GetKey(KeySearchAnime, "a:b", 1)))
+	assert.Equal(t, 1, GetVersion(GetKey(KeyEmptyAnime, 1)))
+	assert.Equal(t, 1, GetVersion("unknown"))
+}
@@ -0,0 +1,17 @@
+package internal
+
+// NopObserver is observer which does nothing. Used
+// when there is no observer set.
+type NopObserver struct{}
+
+// Inc does nothing.
+func (NopObserver) Inc(string, map[string]string) {}
+
+// List of metric name used in malscraper.
+const (
+	MetricNegativeCacheHit    = "malscraper_negative_cache_hit"
+	MetricNegativeCacheSave   = "malscraper_negative_cache_save"
+	MetricNegativeCachePurge  = "malscraper_negative_cache_purge"
+	MetricCircuitBreakerState = "malscraper_circuit_breaker_state"
+	MetricStaleCacheHit       = "malscraper_stale_cache_hit"
+)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/schedule"
 	"github.com/rl404/go-malscraper/pkg/utils"
 )
 
@@ -117,6 +118,7 @@ func (d *detail) setFavorite() {
 }
 
 func (d *detail) setOtherInfo() {
+	d.data.BroadcastTime = model.Broadcast{Unknown: true}
 	d.area.Find("td.borderClass").First().Find("h2").Each(func(i int, area *goquery.Selection) {
 		if area.Text() == "Information" {
 			area = area.Next()
@@ -128,21 +130,22 @@ func (d *detail) setOtherInfo() {
 
 				switch infoType {
 				case "type":
-					d.data.Type = value
+					d.data.Type = model.ParseAnimeType(value)
 				case "episodes":
 					d.data.Episode = utils.StrToNum(value)
 				case "status":
-					d.data.Status = value
+					d.data.Status = model.ParseAnimeStatus(value)
 				case "premiered":
 					d.data.Premiered = value
 				case "broadcast":
 					d.data.Broadcast = value
+					d.data.BroadcastTime = schedule.ParseBroadcast(value)
 				case "source":
-					d.data.Source = value
+					d.data.Source = model.ParseAnimeSource(value)
 				case "duration":
 					d.data.Duration = value
 				case "rating":
-					d.data.Rating = value
+					d.data.Rating = model.ParseAnimeRating(value)
 				case "aired":
 					d.data.AiringDate.Start, d.data.AiringDate.End = d.getAiringInfo(value)
 				case "producers":
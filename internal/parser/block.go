@@ -0,0 +1,158 @@
+package parser
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Requests will not be sent to MyAnimeList for this duration
+// after being blocked, unless MyAnimeList tells how long
+// to wait (`Retry-After` header).
+const blockCooldown = time.Minute
+
+// Testable time now func.
+var timeNow = time.Now
+
+// Page signatures of MyAnimeList pages which are returned with
+// 200 but not the requested page. Titles are lowercase and
+// compared with lowercased `<title>`.
+var (
+	blockedTitles = [][]byte{
+		[]byte("too many requests"),
+		[]byte("just a moment..."),
+		[]byte("attention required"),
+	}
+	maintenanceTitles = [][]byte{
+		[]byte("maintenance"),
+	}
+	captchaSignatures = [][]byte{
+		[]byte("g-recaptcha"),
+		[]byte("h-captcha"),
+		[]byte("/captcha"),
+		[]byte("cf-challenge"),
+	}
+
+	// Every normal MyAnimeList page has this (case-insensitive).
+	normalPageSignature = []byte(`id="contentWrapper"`)
+)
+
+// getPageError to detect block, captcha, and maintenance
+// page from the response body.
+func getPageError(body []byte) (int, error) {
+	// Normal page may have those words in its title (e.g. anime
+	// titled "Too Many Requests" or club titled "Maintenance").
+	if containsFold(body, normalPageSignature) {
+		return http.StatusOK, nil
+	}
+
+	title := bytes.ToLower(getTitle(body))
+
+	switch {
+	case containsAny(title, blockedTitles):
+		return http.StatusTooManyRequests, errors.ErrBlocked
+	case containsAny(title, maintenanceTitles):
+		return http.StatusServiceUnavailable, errors.ErrMaintenance
+	case containsAny(body, captchaSignatures):
+		return http.StatusTooManyRequests, errors.ErrBlocked
+	default:
+		return http.StatusOK, nil
+	}
+}
+
+// getStatusError to convert non-200 response code to error.
+func getStatusError(code int) error {
+	switch code {
+	case http.StatusTooManyRequests:
+		return errors.ErrBlocked
+	case http.StatusServiceUnavailable:
+		return errors.ErrMaintenance
+	default:
+		return errors.ErrNot200
+	}
+}
+
+func getTitle(body []byte) []byte {
+	start := indexFold(body, []byte("<title>"))
+	if start < 0 {
+		return nil
+	}
+	start += len("<title>")
+
+	end := indexFold(body[start:], []byte("</title>"))
+	if end < 0 {
+		return nil
+	}
+
+	return body[start : start+end]
+}
+
+// indexFold is case-insensitive `bytes.Index` for ASCII
+// `sub` without lowercasing the whole `s`.
+func indexFold(s, sub []byte) int {
+	if len(sub) == 0 {
+		return 0
+	}
+
+	lower, upper := bytes.ToLower(sub[:1])[0], bytes.ToUpper(sub[:1])[0]
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if c := s[i]; c != lower && c != upper {
+			continue
+		}
+		if bytes.EqualFold(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsFold(s, sub []byte) bool {
+	return indexFold(s, sub) >= 0
+}
+
+func containsAny(s []byte, subs [][]byte) bool {
+	for _, sub := range subs {
+		if bytes.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRetryAfter to get cooldown duration from `Retry-After`
+// header in seconds. HTTP-date format is not supported.
+func getRetryAfter(header http.Header) time.Duration {
+	s, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || s <= 0 {
+		return blockCooldown
+	}
+	return time.Duration(s) * time.Second
+}
+
+// blocked to handle blocked response. Let the http client handle
+// it if it can (e.g. proxy pool), otherwise pause all requests.
+func (p *Parser) blocked(resp *http.Response) {
+	if h, ok := p.http.(service.BlockHandler); ok {
+		h.Blocked(resp)
+		return
+	}
+	p.setBlocked(getRetryAfter(resp.Header))
+}
+
+// isBlocked to check if still in cooldown after being blocked.
+func (p *Parser) isBlocked() bool {
+	p.blockMu.Lock()
+	defer p.blockMu.Unlock()
+	return timeNow().Before(p.blockedUntil)
+}
+
+// setBlocked to start cooldown.
+func (p *Parser) setBlocked(d time.Duration) {
+	p.blockMu.Lock()
+	defer p.blockMu.Unlock()
+	p.blockedUntil = timeNow().Add(d)
+}
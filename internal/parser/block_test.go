@@ -0,0 +1,188 @@
+package parser
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal/parser/mocks"
+	"github.com/rl404/go-malscraper/pkg/proxypool"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetPageError(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		code int
+		err  error
+	}{
+		{
+			name: "normal",
+			body: `<html><title>Cowboy Bebop - MyAnimeList.net</title><div id="contentWrapper">under maintenance g-recaptcha</div></html>`,
+			code: http.StatusOK,
+		},
+		{
+			name: "normal-maintenance-title",
+			body: `<html><title>Server Maintenance Club - MyAnimeList.net</title><div id="contentWrapper"></div></html>`,
+			code: http.StatusOK,
+		},
+		{
+			name: "normal-blocked-title",
+			body: `<html><TITLE>Too Many Requests - MyAnimeList.net</TITLE><div id="contentwrapper"></div></html>`,
+			code: http.StatusOK,
+		},
+		{
+			name: "normal-captcha-title",
+			body: `<html><title>Just a Moment... - MyAnimeList.net</title><div id="contentWrapper"></div></html>`,
+			code: http.StatusOK,
+		},
+		{
+			name: "json",
+			body: `[{"status":1}]`,
+			code: http.StatusOK,
+		},
+		{
+			name: "too-many-requests",
+			body: `<html><Title>429 Too Many Requests</Title></html>`,
+			code: http.StatusTooManyRequests,
+			err:  errors.ErrBlocked,
+		},
+		{
+			name: "captcha",
+			body: `<html><title>MyAnimeList.net</title><form action="/submission/captcha"><div class="g-recaptcha"></div></form></html>`,
+			code: http.StatusTooManyRequests,
+			err:  errors.ErrBlocked,
+		},
+		{
+			name: "maintenance",
+			body: `<html><title>MyAnimeList.net - Under Maintenance</title></html>`,
+			code: http.StatusServiceUnavailable,
+			err:  errors.ErrMaintenance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := getPageError([]byte(tt.body))
+			assert.Equal(t, tt.code, code)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestGetStatusError(t *testing.T) {
+	assert.Equal(t, errors.ErrBlocked, getStatusError(http.StatusTooManyRequests))
+	assert.Equal(t, errors.ErrMaintenance, getStatusError(http.StatusServiceUnavailable))
+	assert.Equal(t, errors.ErrNot200, getStatusError(http.StatusNotFound))
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	assert.Equal(t, blockCooldown, getRetryAfter(http.Header{}))
+	assert.Equal(t, blockCooldown, getRetryAfter(http.Header{"Retry-After": {"Wed, 21 Oct 2015 07:28:00 GMT"}}))
+	assert.Equal(t, 2*time.Minute, getRetryAfter(http.Header{"Retry-After": {"120"}}))
+}
+
+func TestGetBodyBlocked(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	newResponse := func(code int, body string, header http.Header) *http.Response {
+		return &http.Response{
+			StatusCode: code,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	mockRequester := new(mocks.Requester)
+	p := &Parser{logger: mallogger.New(0, false), http: mockRequester}
+
+	t.Run("ok", func(t *testing.T) {
+		mockRequester.On("Do", mock.Anything).Return(newResponse(http.StatusOK, "ok", nil), nil).Once()
+		body, code, err := p.getBody(malURL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		d, _ := ioutil.ReadAll(body)
+		assert.Equal(t, "ok", string(d))
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		mockRequester.On("Do", mock.Anything).Return(newResponse(http.StatusOK, "<title>Maintenance</title>", nil), nil).Once()
+		_, code, err := p.getBody(malURL)
+		assert.Equal(t, errors.ErrMaintenance, err)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.False(t, p.isBlocked())
+	})
+
+	t.Run("blocked", func(t *testing.T) {
+		mockRequester.On("Do", mock.Anything).Return(newResponse(http.StatusTooManyRequests, "", http.Header{"Retry-After": {"10"}}), nil).Once()
+		_, code, err := p.getBody(malURL)
+		assert.Equal(t, errors.ErrBlocked, err)
+		assert.Equal(t, http.StatusTooManyRequests, code)
+		assert.True(t, p.isBlocked())
+
+		// Not requesting while blocked.
+		_, code, err = p.getBody(malURL)
+		assert.Equal(t, errors.ErrBlocked, err)
+		assert.Equal(t, http.StatusTooManyRequests, code)
+
+		now = now.Add(10 * time.Second)
+		assert.False(t, p.isBlocked())
+	})
+
+	t.Run("captcha", func(t *testing.T) {
+		mockRequester.On("Do", mock.Anything).Return(newResponse(http.StatusOK, `<div class="g-recaptcha"></div>`, http.Header{}), nil).Once()
+		_, code, err := p.getBody(malURL)
+		assert.Equal(t, errors.ErrBlocked, err)
+		assert.Equal(t, http.StatusTooManyRequests, code)
+		assert.True(t, p.isBlocked())
+	})
+
+	mockRequester.AssertExpectations(t)
+}
+
+func TestGetBodyBlockedProxy(t *testing.T) {
+	var blockedHits, healthyHits int
+	blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		blockedHits++
+		w.Write([]byte(`<html><title>Just a moment...</title></html>`))
+	}))
+	defer blocked.Close()
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		healthyHits++
+		w.Write([]byte(`<html><div id="contentWrapper"></div></html>`))
+	}))
+	defer healthy.Close()
+
+	pool, err := proxypool.New(blocked.URL, healthy.URL)
+	assert.NoError(t, err)
+
+	p := New(false, false, mallogger.New(0, false), Config{
+		HTTPClient: pool,
+		// Local proxies can't tunnel https.
+		RequestHook: func(r *http.Request) { r.URL.Scheme = "http" },
+	})
+
+	_, code, err := p.getBody(malURL)
+	assert.Equal(t, errors.ErrBlocked, err)
+	assert.Equal(t, http.StatusTooManyRequests, code)
+
+	// Only the blocked proxy is cooling down.
+	assert.False(t, p.isBlocked())
+	for i := 0; i < 3; i++ {
+		_, code, err = p.getBody(malURL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+	}
+
+	assert.Equal(t, 1, blockedHits)
+	assert.Equal(t, 3, healthyHits)
+}
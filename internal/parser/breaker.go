@@ -0,0 +1,139 @@
+package parser
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+)
+
+// Circuit breaker states.
+const (
+	stateClosed   = "closed"
+	stateOpen     = "open"
+	stateHalfOpen = "half-open"
+)
+
+// Default circuit breaker open duration before
+// trying to request MyAnimeList again.
+const defaultBreakerTimeout = 30 * time.Second
+
+// breaker is circuit breaker around MyAnimeList requests.
+// It opens after `threshold` consecutive failures and
+// rejects all requests until `timeout` has passed. Then, it
+// becomes half-open and lets 1 request through to check
+// if MyAnimeList is available again.
+type breaker struct {
+	sync.Mutex
+	threshold int
+	timeout   time.Duration
+	state     string
+	failures  int
+	openedAt  time.Time
+	probing   bool
+	onChange  func(from, to string)
+}
+
+func newBreaker(threshold int, timeout time.Duration, onChange func(from, to string)) *breaker {
+	if timeout <= 0 {
+		timeout = defaultBreakerTimeout
+	}
+	return &breaker{
+		threshold: threshold,
+		timeout:   timeout,
+		state:     stateClosed,
+		onChange:  onChange,
+	}
+}
+
+// allow to check if request can be sent. Nil breaker
+// always allows.
+func (b *breaker) allow() bool {
+	if b == nil {
+		return true
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if timeNow().Before(b.openedAt.Add(b.timeout)) {
+			return false
+		}
+		b.setState(stateHalfOpen)
+		b.probing = true
+		return true
+	case stateHalfOpen:
+		// Only 1 probe request at a time.
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// done to record the request result.
+func (b *breaker) done(code int, err error) {
+	if b == nil {
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.probing = false
+
+	if !isFailure(code, err) {
+		b.failures = 0
+		if b.state != stateClosed {
+			b.setState(stateClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == stateHalfOpen || b.failures >= b.threshold {
+		b.openedAt = timeNow()
+		if b.state != stateOpen {
+			b.setState(stateOpen)
+		}
+	}
+}
+
+// isOpen to check if requests are being rejected.
+func (b *breaker) isOpen() bool {
+	if b == nil {
+		return false
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	return b.state != stateClosed
+}
+
+func (b *breaker) setState(state string) {
+	from := b.state
+	b.state = state
+	if b.onChange != nil {
+		b.onChange(from, state)
+	}
+}
+
+// isFailure to check if the request result means MyAnimeList
+// is unavailable. Not found and other client errors are not
+// counted as failure.
+func isFailure(code int, err error) bool {
+	switch err {
+	case errors.ErrHTTPRequest, errors.ErrBlocked, errors.ErrMaintenance:
+		return true
+	case errors.ErrNot200:
+		return code >= http.StatusInternalServerError
+	default:
+		return false
+	}
+}
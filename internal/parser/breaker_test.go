@@ -0,0 +1,116 @@
+package parser
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal/parser/mocks"
+	serviceMocks "github.com/rl404/go-malscraper/service/mocks"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIsFailure(t *testing.T) {
+	assert.False(t, isFailure(http.StatusOK, nil))
+	assert.False(t, isFailure(http.StatusNotFound, errors.ErrNot200))
+	assert.False(t, isFailure(http.StatusInternalServerError, errors.ErrParseBody))
+	assert.True(t, isFailure(http.StatusBadGateway, errors.ErrNot200))
+	assert.True(t, isFailure(http.StatusInternalServerError, errors.ErrHTTPRequest))
+	assert.True(t, isFailure(http.StatusTooManyRequests, errors.ErrBlocked))
+	assert.True(t, isFailure(http.StatusServiceUnavailable, errors.ErrMaintenance))
+}
+
+func TestBreaker(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	var changes []string
+	b := newBreaker(2, time.Minute, func(from, to string) {
+		changes = append(changes, from+">"+to)
+	})
+
+	// Nil breaker is disabled.
+	var nilBreaker *breaker
+	assert.True(t, nilBreaker.allow())
+	assert.False(t, nilBreaker.isOpen())
+	nilBreaker.done(http.StatusInternalServerError, errors.ErrHTTPRequest)
+
+	// Failure count is reset after success.
+	assert.True(t, b.allow())
+	b.done(http.StatusInternalServerError, errors.ErrHTTPRequest)
+	assert.True(t, b.allow())
+	b.done(http.StatusNotFound, errors.ErrNot200)
+	assert.True(t, b.allow())
+	b.done(http.StatusInternalServerError, errors.ErrHTTPRequest)
+	assert.False(t, b.isOpen())
+
+	// Open after consecutive failures.
+	assert.True(t, b.allow())
+	b.done(http.StatusTooManyRequests, errors.ErrBlocked)
+	assert.True(t, b.isOpen())
+	assert.False(t, b.allow())
+
+	// Half-open after timeout, only 1 probe.
+	now = now.Add(time.Minute)
+	assert.True(t, b.allow())
+	assert.False(t, b.allow())
+
+	// Failed probe opens it again.
+	b.done(http.StatusServiceUnavailable, errors.ErrMaintenance)
+	assert.False(t, b.allow())
+
+	// Succeeded probe closes it.
+	now = now.Add(time.Minute)
+	assert.True(t, b.allow())
+	b.done(http.StatusOK, nil)
+	assert.False(t, b.isOpen())
+	assert.True(t, b.allow())
+
+	assert.Equal(t, []string{
+		"closed>open",
+		"open>half-open",
+		"half-open>open",
+		"open>half-open",
+		"half-open>closed",
+	}, changes)
+}
+
+func TestGetBodyCircuitOpen(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockRequester := new(mocks.Requester)
+	mockObserver := new(serviceMocks.Observer)
+	mockObserver.On("Inc", "malscraper_circuit_breaker_state", map[string]string{"from": "closed", "to": "open"}).Once()
+
+	p := New(false, false, mallogger.New(0, false), Config{
+		BreakerThreshold: 1,
+		Observer:         mockObserver,
+	})
+	p.http = mockRequester
+
+	mockRequester.On("Do", mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusBadGateway,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}, nil).Once()
+
+	_, code, err := p.getBody(malURL)
+	assert.Equal(t, errors.ErrNot200, err)
+	assert.Equal(t, http.StatusBadGateway, code)
+	assert.True(t, p.IsCircuitOpen())
+
+	// Fail fast without requesting.
+	_, code, err = p.getBody(malURL)
+	assert.Equal(t, errors.ErrCircuitOpen, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	mockRequester.AssertExpectations(t)
+	mockObserver.AssertExpectations(t)
+}
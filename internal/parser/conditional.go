@@ -0,0 +1,105 @@
+package parser
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rl404/go-malscraper/internal"
+)
+
+// Conditional request won't be sent to the same URL within
+// this duration after getting 304. It means the previous 304
+// could not be used (cached data is gone or older than the
+// page) and the page is requested again.
+const notModifiedRetryTime = time.Minute
+
+// httpValidator is response validator to make conditional
+// request (`ETag` and `Last-Modified` header).
+type httpValidator struct {
+	ETag         string    `json:"etag"`
+	LastModified string    `json:"lastModified"`
+	ChangedAt    time.Time `json:"changedAt"`
+}
+
+// getValidator to get the URL's response validator from
+// the last request.
+func (p *Parser) getValidator(url string) (v httpValidator) {
+	if p.cacher != nil {
+		_ = p.cacher.Get(internal.GetKey(internal.KeyHTTPValidator, url), &v)
+	}
+	return v
+}
+
+// setConditionalHeaders to set `If-None-Match` and `If-Modified-Since`
+// header if the URL has been requested before.
+func (p *Parser) setConditionalHeaders(request *http.Request, url string, v httpValidator) {
+	if p.cacher == nil || p.isNotModifiedRetry(url) {
+		return
+	}
+
+	if v.ETag != "" {
+		request.Header.Set("If-None-Match", v.ETag)
+	}
+	if v.LastModified != "" {
+		request.Header.Set("If-Modified-Since", v.LastModified)
+	}
+}
+
+// saveValidator to save response validator for the next request.
+// Page shared by several methods may be requested again with
+// the same validator, so the time the validator changed is kept.
+func (p *Parser) saveValidator(url string, header http.Header, old httpValidator) {
+	if p.cacher == nil {
+		return
+	}
+
+	v := httpValidator{
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+		ChangedAt:    timeNow(),
+	}
+	if v.ETag == "" && v.LastModified == "" {
+		return
+	}
+
+	if v.ETag == old.ETag && v.LastModified == old.LastModified && !old.ChangedAt.IsZero() {
+		v.ChangedAt = old.ChangedAt
+	}
+
+	if err := p.cacher.Set(internal.GetKey(internal.KeyHTTPValidator, url), v); err != nil {
+		p.logger.Warn("failed saving %s validator: %s", url, err.Error())
+	}
+}
+
+// isNotModifiedRetry to check if the URL just got 304.
+func (p *Parser) isNotModifiedRetry(url string) bool {
+	p.notModifiedMu.Lock()
+	defer p.notModifiedMu.Unlock()
+
+	t, ok := p.notModified[url]
+	if !ok {
+		return false
+	}
+	delete(p.notModified, url)
+	return timeNow().Before(t.Add(notModifiedRetryTime))
+}
+
+// setNotModified to mark the URL just got 304.
+func (p *Parser) setNotModified(url string) {
+	p.notModifiedMu.Lock()
+	defer p.notModifiedMu.Unlock()
+
+	if p.notModified == nil {
+		p.notModified = make(map[string]time.Time)
+	}
+
+	// Clean up old ones.
+	now := timeNow()
+	for u, t := range p.notModified {
+		if !now.Before(t.Add(notModifiedRetryTime)) {
+			delete(p.notModified, u)
+		}
+	}
+
+	p.notModified[url] = now
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	e "errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal/parser/mocks"
+	"github.com/rl404/mal-plugin/cache/bigcache"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetBodyConditional(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	c, err := bigcache.New(time.Hour)
+	assert.NoError(t, err)
+
+	mockRequester := new(mocks.Requester)
+	p := New(false, false, mallogger.New(0, false), Config{
+		HTTPClient: mockRequester,
+		Cacher:     c,
+	})
+
+	newResponse := func(code int, etag string) *http.Response {
+		return &http.Response{
+			StatusCode: code,
+			Header:     http.Header{"Etag": {etag}, "Last-Modified": {"Thu, 01 Apr 2021 00:00:00 GMT"}},
+			Body:       ioutil.NopCloser(strings.NewReader("ok")),
+		}
+	}
+	withETag := func(etag string) interface{} {
+		return mock.MatchedBy(func(r *http.Request) bool {
+			return r.Header.Get("If-None-Match") == etag
+		})
+	}
+
+	t.Run("first", func(t *testing.T) {
+		mockRequester.On("Do", withETag("")).Return(newResponse(http.StatusOK, `"a"`), nil).Once()
+		_, code, err := p.getBody(malURL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("same-etag", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		mockRequester.On("Do", withETag(`"a"`)).Return(newResponse(http.StatusOK, `"a"`), nil).Once()
+		_, _, err := p.getBody(malURL)
+		assert.NoError(t, err)
+		assert.Equal(t, now.Add(-time.Minute), p.getValidator(malURL).ChangedAt)
+	})
+
+	t.Run("not-modified", func(t *testing.T) {
+		mockRequester.On("Do", withETag(`"a"`)).Return(newResponse(http.StatusNotModified, ""), nil).Once()
+		_, code, err := p.getBody(malURL)
+		assert.Equal(t, http.StatusNotModified, code)
+		assert.True(t, e.Is(err, errors.ErrNotModified))
+
+		var nmErr *errors.NotModifiedError
+		assert.True(t, e.As(err, &nmErr))
+		assert.Equal(t, now.Add(-time.Minute), nmErr.Since)
+	})
+
+	t.Run("retry-without-conditional", func(t *testing.T) {
+		mockRequester.On("Do", withETag("")).Return(newResponse(http.StatusOK, `"b"`), nil).Once()
+		_, _, err := p.getBody(malURL)
+		assert.NoError(t, err)
+		assert.Equal(t, now, p.getValidator(malURL).ChangedAt)
+	})
+
+	mockRequester.AssertExpectations(t)
+}
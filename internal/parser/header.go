@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// setHeaders to set request headers. Config headers are set
+// first, then User-Agent from provider, then the request hook
+// which can override all of them.
+func (p *Parser) setHeaders(request *http.Request) {
+	for k, v := range p.headers {
+		request.Header.Set(k, v)
+	}
+
+	if p.userAgent != nil {
+		request.Header.Set("User-Agent", p.userAgent.UserAgent())
+	}
+
+	if p.requestHook != nil {
+		p.requestHook(request)
+	}
+}
+
+// headerProfile to describe the request headers for logging.
+func headerProfile(request *http.Request) string {
+	keys := make([]string, 0, len(request.Header))
+	for k := range request.Header {
+		if k != "User-Agent" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	ua := request.Header.Get("User-Agent")
+	if ua == "" {
+		ua = "default"
+	}
+
+	return fmt.Sprintf("ua=%q headers=[%s]", ua, strings.Join(keys, ","))
+}
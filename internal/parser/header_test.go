@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rl404/go-malscraper/internal/parser/mocks"
+	"github.com/rl404/go-malscraper/pkg/useragent"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetHeaders(t *testing.T) {
+	p := New(false, false, mallogger.New(0, false), Config{
+		Headers: map[string]string{
+			"User-Agent":      "config",
+			"Accept-Language": "ja",
+			"X-Test":          "test",
+		},
+		UserAgent: useragent.New("ua1", "ua2"),
+		RequestHook: func(r *http.Request) {
+			r.Header.Set("Accept-Language", "en-US")
+		},
+	})
+
+	for _, ua := range []string{"ua1", "ua2"} {
+		r, _ := http.NewRequest("GET", malURL, nil)
+		p.setHeaders(r)
+		assert.Equal(t, ua, r.Header.Get("User-Agent"))
+		assert.Equal(t, "en-US", r.Header.Get("Accept-Language"))
+		assert.Equal(t, "test", r.Header.Get("X-Test"))
+		assert.Equal(t, `ua="`+ua+`" headers=[Accept-Language,X-Test]`, headerProfile(r))
+	}
+}
+
+func TestHeaderProfileDefault(t *testing.T) {
+	r, _ := http.NewRequest("GET", malURL, nil)
+	assert.Equal(t, `ua="default" headers=[]`, headerProfile(r))
+}
+
+func TestGetBodyHeaders(t *testing.T) {
+	mockRequester := new(mocks.Requester)
+	p := New(false, false, mallogger.New(0, false), Config{
+		HTTPClient: mockRequester,
+		Headers:    map[string]string{"Accept-Language": "en-US"},
+	})
+
+	mockRequester.On("Do", mock.MatchedBy(func(r *http.Request) bool {
+		return r.Header.Get("Accept-Language") == "en-US"
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader("ok")),
+	}, nil).Once()
+
+	_, _, err := p.getBody(malURL)
+	assert.NoError(t, err)
+	mockRequester.AssertExpectations(t)
+}
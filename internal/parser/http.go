@@ -1,8 +1,10 @@
 package parser
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"strings"
 	"time"
@@ -20,12 +22,46 @@ var parseHTML = goquery.NewDocumentFromReader
 var timeSince = time.Since
 
 func (p *Parser) getBody(url string) (io.ReadCloser, int, error) {
+	// Offline parser only uses cached raw page.
+	if p.offline {
+		return p.getPage(url)
+	}
+
+	// Only record the request in dry-run mode.
+	if p.planner != nil {
+		p.logger.Debug("%s skipped, dry-run mode", url)
+		p.planner.AddRequest(url)
+		return nil, http.StatusAccepted, errors.ErrDryRun
+	}
+
+	// Don't request while being blocked.
+	if p.isBlocked() {
+		p.logger.Debug("%s skipped, still blocked", url)
+		return nil, http.StatusTooManyRequests, errors.ErrBlocked
+	}
+
+	// Fail fast while MyAnimeList is unavailable.
+	if !p.breaker.allow() {
+		p.logger.Debug("%s skipped, circuit breaker is open", url)
+		return nil, http.StatusServiceUnavailable, errors.ErrCircuitOpen
+	}
+
+	body, code, err := p.requestBody(url)
+	p.breaker.done(code, err)
+	return body, code, err
+}
+
+func (p *Parser) requestBody(url string) (io.ReadCloser, int, error) {
 	// Prepare request.
 	request, err := httpRequest("GET", url, nil)
 	if err != nil {
 		p.logger.Error("failed preparing request: %s", err.Error())
 		return nil, http.StatusInternalServerError, errors.ErrPrepareRequest
 	}
+	p.setHeaders(request)
+	validator := p.getValidator(url)
+	p.setConditionalHeaders(request, url, validator)
+	p.logger.Debug("%s %s", url, headerProfile(request))
 
 	// Do request.
 	t := time.Now()
@@ -33,15 +69,41 @@ func (p *Parser) getBody(url string) (io.ReadCloser, int, error) {
 	if err != nil {
 		return nil, http.StatusInternalServerError, errors.ErrHTTPRequest
 	}
+	defer resp.Body.Close()
 
 	// Header check.
 	p.logger.Debug("%s %v (%s)", url, resp.StatusCode, timeSince(t).Truncate(time.Microsecond))
+	if resp.StatusCode == http.StatusNotModified {
+		p.setNotModified(url)
+		return nil, resp.StatusCode, &errors.NotModifiedError{Since: validator.ChangedAt}
+	}
 	if resp.StatusCode != http.StatusOK {
-		resp.Body.Close()
-		return nil, resp.StatusCode, errors.ErrNot200
+		if resp.StatusCode == http.StatusTooManyRequests {
+			p.blocked(resp)
+		}
+		return nil, resp.StatusCode, getStatusError(resp.StatusCode)
+	}
+
+	// Read body.
+	body, err := readBody(resp.Body)
+	if err != nil {
+		p.logger.Error("failed reading body: %s", err.Error())
+		return nil, http.StatusInternalServerError, errors.ErrParseBody
 	}
 
-	return resp.Body, resp.StatusCode, nil
+	// Block, captcha, and maintenance page check.
+	if code, err := getPageError(body); err != nil {
+		p.logger.Error("%s: %s", url, err.Error())
+		if err == errors.ErrBlocked {
+			p.blocked(resp)
+		}
+		return nil, code, err
+	}
+
+	p.saveValidator(url, resp.Header, validator)
+	p.savePage(url, body)
+
+	return ioutil.NopCloser(bytes.NewReader(body)), resp.StatusCode, nil
 }
 
 func (p *Parser) getDoc(url string, area string) (*goquery.Selection, int, error) {
@@ -119,13 +119,13 @@ func (d *detail) setOtherInfo() {
 
 				switch infoType {
 				case "type":
-					d.data.Type = value
+					d.data.Type = model.ParseMangaType(value)
 				case "volumes":
 					d.data.Volume = utils.StrToNum(value)
 				case "chapters":
 					d.data.Chapter = utils.StrToNum(value)
 				case "status":
-					d.data.Status = value
+					d.data.Status = model.ParseMangaStatus(value)
 				case "published":
 					d.data.PublishingDate.Start, d.data.PublishingDate.End = d.getAiringInfo(value)
 				case "serialization":
@@ -0,0 +1,277 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/service"
+)
+
+// pageCache stores raw MyAnimeList pages so they can be
+// parsed again without requesting MyAnimeList. Shared
+// with the offline parser.
+//
+// Each entity has a page index listing its saved pages since
+// the cacher can't list keys. Changes are kept in memory and
+// merged to the saved index in background once in a while,
+// so saving a page doesn't read and write the whole index.
+type pageCache struct {
+	cacher service.Cacher
+	ttl    time.Duration
+
+	sync.Mutex // guards fields below
+	// Page index changes which are not saved yet. Key is
+	// entity then URL. Zero time means the page is deleted.
+	pending   map[string]map[string]time.Time
+	flushedAt time.Time
+	flushing  bool
+
+	flushMu sync.Mutex // serializes saving page index
+}
+
+func newPageCache(c service.Cacher, ttl time.Duration) *pageCache {
+	return &pageCache{
+		cacher:    c,
+		ttl:       ttl,
+		pending:   make(map[string]map[string]time.Time),
+		flushedAt: timeNow(),
+	}
+}
+
+const (
+	// Maximum number of pages in each entity's page index. The
+	// oldest ones are removed from the index (not from the page
+	// cache) when it is full.
+	maxPageIndexSize = 10000
+
+	// How often page index changes are saved.
+	pageIndexFlushInterval = time.Minute
+)
+
+// pageData is raw MyAnimeList page (HTML or JSON).
+type pageData struct {
+	Body     []byte    `json:"body"`
+	CachedAt time.Time `json:"cachedAt"`
+}
+
+// isPageValid to check if the raw page is not older
+// than page cache time.
+func (p *Parser) isPageValid(cachedAt time.Time) bool {
+	return p.page.ttl <= 0 || timeNow().Before(cachedAt.Add(p.page.ttl))
+}
+
+// getPage to get raw page from page cache.
+func (p *Parser) getPage(url string) (io.ReadCloser, int, error) {
+	if p.page == nil {
+		return nil, http.StatusNotFound, errors.ErrPageNotCached
+	}
+
+	var d pageData
+	if err := p.page.cacher.Get(internal.GetKey(internal.KeyPage, url), &d); err != nil || !p.isPageValid(d.CachedAt) {
+		p.logger.Debug("%s not in page cache", url)
+		p.deletePageIndex(url)
+		return nil, http.StatusNotFound, errors.ErrPageNotCached
+	}
+	p.logger.Debug("%s from page cache", url)
+	return ioutil.NopCloser(bytes.NewReader(d.Body)), http.StatusOK, nil
+}
+
+// savePage to save raw page to page cache.
+func (p *Parser) savePage(url string, body []byte) {
+	if p.page == nil {
+		return
+	}
+
+	d := pageData{
+		Body:     body,
+		CachedAt: timeNow(),
+	}
+	if err := p.page.cacher.Set(internal.GetKey(internal.KeyPage, url), d); err != nil {
+		p.logger.Error("failed saving %s to page cache: %s", url, err.Error())
+		return
+	}
+
+	p.addPageIndex(url, d.CachedAt)
+}
+
+// Only pages with URL that can be parsed by `ParseURL` are
+// listed in page index so they can be re-parsed later.
+func getPageEntity(url string) (string, bool) {
+	u, err := ParseURL(url)
+	if err != nil {
+		return "", false
+	}
+	return u.Entity, true
+}
+
+func (p *Parser) getPageIndex(key string) map[string]time.Time {
+	index := make(map[string]time.Time)
+	_ = p.page.cacher.Get(key, &index)
+	return index
+}
+
+func (p *Parser) addPageIndex(url string, cachedAt time.Time) {
+	p.setPageIndex(url, cachedAt)
+}
+
+func (p *Parser) deletePageIndex(url string) {
+	p.setPageIndex(url, time.Time{})
+}
+
+// setPageIndex to record page index change and start saving
+// the changes in background if it's time to.
+func (p *Parser) setPageIndex(url string, cachedAt time.Time) {
+	entity, ok := getPageEntity(url)
+	if !ok {
+		return
+	}
+
+	p.page.Lock()
+	if p.page.pending[entity] == nil {
+		p.page.pending[entity] = make(map[string]time.Time)
+	}
+	p.page.pending[entity][url] = cachedAt
+
+	flush := !p.page.flushing && timeNow().Sub(p.page.flushedAt) >= pageIndexFlushInterval
+	if flush {
+		p.page.flushing = true
+	}
+	p.page.Unlock()
+
+	if flush {
+		go func() {
+			p.flushPageIndex()
+			p.page.Lock()
+			p.page.flushing = false
+			p.page.Unlock()
+		}()
+	}
+}
+
+// flushPageIndex to merge pending changes to the saved page
+// index. Failed changes are kept to be saved next time.
+func (p *Parser) flushPageIndex() {
+	p.page.flushMu.Lock()
+	defer p.page.flushMu.Unlock()
+
+	p.page.Lock()
+	pending := p.page.pending
+	p.page.pending = make(map[string]map[string]time.Time)
+	p.page.flushedAt = timeNow()
+	p.page.Unlock()
+
+	for entity, changes := range pending {
+		key := internal.GetKey(internal.KeyPageIndex, entity)
+		index := p.getPageIndex(key)
+		for url, cachedAt := range changes {
+			if cachedAt.IsZero() {
+				delete(index, url)
+				continue
+			}
+			index[url] = cachedAt
+		}
+		p.limitPageIndex(index)
+
+		if err := p.page.cacher.Set(key, index); err != nil {
+			p.logger.Error("[%s] failed saving cache: %s", key, err.Error())
+			p.restorePageIndex(entity, changes)
+		}
+	}
+}
+
+// restorePageIndex to put back failed changes without
+// replacing newer ones.
+func (p *Parser) restorePageIndex(entity string, changes map[string]time.Time) {
+	p.page.Lock()
+	defer p.page.Unlock()
+
+	if p.page.pending[entity] == nil {
+		p.page.pending[entity] = make(map[string]time.Time)
+	}
+	for url, cachedAt := range changes {
+		if _, ok := p.page.pending[entity][url]; !ok {
+			p.page.pending[entity][url] = cachedAt
+		}
+	}
+}
+
+// limitPageIndex to remove pages older than page cache time
+// and the oldest ones if the index is full.
+func (p *Parser) limitPageIndex(index map[string]time.Time) {
+	for url, cachedAt := range index {
+		if !p.isPageValid(cachedAt) {
+			delete(index, url)
+		}
+	}
+
+	if len(index) <= maxPageIndexSize {
+		return
+	}
+
+	urls := make([]string, 0, len(index))
+	for url := range index {
+		urls = append(urls, url)
+	}
+	sort.Slice(urls, func(i, j int) bool {
+		return index[urls[i]].Before(index[urls[j]])
+	})
+
+	for _, url := range urls[:len(urls)-maxPageIndexSize] {
+		delete(index, url)
+	}
+}
+
+// ListPages to get URL list of the entity's cached raw pages.
+// Pages older than page cache time are excluded. Pending page
+// index changes are saved first.
+func (p *Parser) ListPages(entity string) []string {
+	if p.page == nil {
+		return nil
+	}
+
+	p.flushPageIndex()
+	index := p.getPageIndex(internal.GetKey(internal.KeyPageIndex, entity))
+
+	urls := []string{}
+	for url, cachedAt := range index {
+		if p.isPageValid(cachedAt) {
+			urls = append(urls, url)
+		}
+	}
+	sort.Strings(urls)
+
+	return urls
+}
+
+// Offline to create a parser which parses cached raw
+// pages instead of requesting MyAnimeList. Page which is
+// not in page cache returns `ErrPageNotCached`.
+func (p *Parser) Offline() *Parser {
+	return &Parser{
+		anime:          p.anime,
+		manga:          p.manga,
+		character:      p.character,
+		people:         p.people,
+		producer:       p.producer,
+		genre:          p.genre,
+		review:         p.review,
+		recommendation: p.recommendation,
+		news:           p.news,
+		article:        p.article,
+		club:           p.club,
+		top:            p.top,
+		user:           p.user,
+		search:         p.search,
+		logger:         p.logger,
+		observer:       p.observer,
+		page:           p.page,
+		offline:        true,
+	}
+}
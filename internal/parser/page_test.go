@@ -0,0 +1,154 @@
+package parser
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/internal/parser/mocks"
+	"github.com/rl404/mal-plugin/cache/bigcache"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPageCache(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	c, err := bigcache.New(time.Hour)
+	assert.NoError(t, err)
+
+	mockRequester := new(mocks.Requester)
+	p := New(false, false, mallogger.New(0, false), Config{
+		HTTPClient:    mockRequester,
+		PageCacher:    c,
+		PageCacheTime: time.Hour,
+	})
+	offline := p.Offline()
+
+	animeURL := malURL + "/anime/1"
+	searchURL := malURL + "/anime.php?q=bebop"
+
+	for _, url := range []string{animeURL, searchURL} {
+		mockRequester.On("Do", mock.Anything).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(url)),
+		}, nil).Once()
+		_, _, err := p.getBody(url)
+		assert.NoError(t, err)
+	}
+
+	t.Run("list", func(t *testing.T) {
+		assert.Equal(t, []string{animeURL}, p.ListPages("anime"))
+		assert.Empty(t, p.ListPages("manga"))
+	})
+
+	t.Run("offline", func(t *testing.T) {
+		for _, url := range []string{animeURL, searchURL} {
+			body, code, err := offline.getBody(url)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, code)
+			d, _ := ioutil.ReadAll(body)
+			assert.Equal(t, url, string(d))
+		}
+
+		_, code, err := offline.getBody(malURL + "/anime/2")
+		assert.Equal(t, errors.ErrPageNotCached, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		assert.Empty(t, p.ListPages("anime"))
+
+		_, _, err := offline.getBody(animeURL)
+		assert.Equal(t, errors.ErrPageNotCached, err)
+		p.flushPageIndex()
+		assert.Empty(t, p.getPageIndex("mal:page-index:anime"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		p := New(false, false, mallogger.New(0, false), Config{})
+		assert.Nil(t, p.ListPages("anime"))
+		_, _, err := p.Offline().getBody(animeURL)
+		assert.Equal(t, errors.ErrPageNotCached, err)
+	})
+
+	mockRequester.AssertExpectations(t)
+}
+
+func TestPageIndexFlush(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	timeNow = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	defer func() { timeNow = time.Now }()
+
+	c, err := bigcache.New(time.Hour)
+	assert.NoError(t, err)
+
+	p := New(false, false, mallogger.New(0, false), Config{PageCacher: c})
+	key := "mal:page-index:anime"
+
+	// Kept in memory until flush interval.
+	p.savePage(malURL+"/anime/1", []byte("1"))
+	assert.Empty(t, p.getPageIndex(key))
+
+	mu.Lock()
+	now = now.Add(pageIndexFlushInterval)
+	mu.Unlock()
+
+	// Saved in background.
+	p.savePage(malURL+"/anime/2", []byte("2"))
+	assert.Eventually(t, func() bool {
+		return len(p.getPageIndex(key)) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// Merged with other instance's index.
+	index := p.getPageIndex(key)
+	index[malURL+"/anime/3"] = now
+	assert.NoError(t, c.Set(key, index))
+	p.deletePageIndex(malURL + "/anime/1")
+	p.flushPageIndex()
+	assert.Equal(t, map[string]time.Time{
+		malURL + "/anime/2": now,
+		malURL + "/anime/3": now,
+	}, p.getPageIndex(key))
+}
+
+func TestLimitPageIndex(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	c, err := bigcache.New(time.Hour)
+	assert.NoError(t, err)
+
+	p := New(false, false, mallogger.New(0, false), Config{
+		PageCacher:    c,
+		PageCacheTime: time.Hour,
+	})
+
+	index := map[string]time.Time{
+		"expired": now.Add(-time.Hour),
+	}
+	for i := 0; i < maxPageIndexSize+1; i++ {
+		index[strconv.Itoa(i)] = now.Add(time.Duration(i) * time.Second)
+	}
+
+	p.limitPageIndex(index)
+	assert.Len(t, index, maxPageIndexSize)
+	assert.NotContains(t, index, "expired")
+	assert.NotContains(t, index, "0")
+	assert.Contains(t, index, strconv.Itoa(maxPageIndexSize))
+}
@@ -2,8 +2,10 @@ package parser
 
 import (
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/rl404/go-malscraper/internal"
 	"github.com/rl404/go-malscraper/internal/parser/anime"
 	"github.com/rl404/go-malscraper/internal/parser/article"
 	"github.com/rl404/go-malscraper/internal/parser/character"
@@ -43,12 +45,62 @@ type Parser struct {
 	user           user.Parser
 	search         search.Parser
 	logger         service.Logger
+	observer       service.Observer
 	http           Requester
+	breaker        *breaker
+	headers        map[string]string
+	userAgent      service.UserAgent
+	requestHook    func(*http.Request)
+	cacher         service.Cacher
+	page           *pageCache
+	offline        bool
+	planner        *internal.Planner
+
+	blockMu      sync.Mutex
+	blockedUntil time.Time
+
+	notModifiedMu sync.Mutex
+	notModified   map[string]time.Time
+}
+
+// Config is parser config.
+type Config struct {
+	// Open circuit breaker after this many consecutive failed
+	// requests. Zero value disables the circuit breaker.
+	BreakerThreshold int
+	// How long the circuit breaker stays open before letting
+	// a request through to check MyAnimeList. Default is 30 seconds.
+	BreakerTimeout time.Duration
+	// Metrics observer.
+	Observer service.Observer
+	// Http client. Default is `*http.Client` with 10 seconds timeout.
+	HTTPClient service.HTTPClient
+	// Headers for every request.
+	Headers map[string]string
+	// User-Agent provider. Overrides User-Agent in `Headers`.
+	UserAgent service.UserAgent
+	// Called before every request is sent to set
+	// per-request headers. Overrides all headers above.
+	RequestHook func(*http.Request)
+	// Cacher to save response `ETag` and `Last-Modified` header
+	// to make conditional request next time. Nil will disable
+	// conditional request.
+	Cacher service.Cacher
+	// Cacher to save raw MyAnimeList pages so they can be parsed
+	// again later without requesting MyAnimeList. Nil will disable
+	// page cache.
+	PageCacher service.Cacher
+	// Raw pages older than this won't be used. Zero means raw pages
+	// can be used as long as they are still in `PageCacher`.
+	PageCacheTime time.Duration
+	// Record requested URL and return `ErrDryRun` instead of
+	// requesting MyAnimeList. Nil will disable dry-run mode.
+	Planner *internal.Planner
 }
 
 // New to create new parser.
-func New(cleanImg, cleanVid bool, l service.Logger) service.API {
-	return &Parser{
+func New(cleanImg, cleanVid bool, l service.Logger, cfg Config) *Parser {
+	p := &Parser{
 		anime:          anime.New(cleanImg, cleanVid),
 		manga:          manga.New(cleanImg),
 		character:      character.New(cleanImg),
@@ -64,8 +116,42 @@ func New(cleanImg, cleanVid bool, l service.Logger) service.API {
 		user:           user.New(cleanImg),
 		search:         search.New(cleanImg),
 		logger:         l,
+		observer:       cfg.Observer,
+		headers:        cfg.Headers,
+		userAgent:      cfg.UserAgent,
+		requestHook:    cfg.RequestHook,
+		cacher:         cfg.Cacher,
+		planner:        cfg.Planner,
 		http: &http.Client{
 			Timeout: 10 * time.Second,
 		},
 	}
+	if p.observer == nil {
+		p.observer = internal.NopObserver{}
+	}
+	if cfg.HTTPClient != nil {
+		p.http = cfg.HTTPClient
+	}
+	if cfg.PageCacher != nil {
+		p.page = newPageCache(cfg.PageCacher, cfg.PageCacheTime)
+	}
+	if cfg.BreakerThreshold > 0 {
+		p.breaker = newBreaker(cfg.BreakerThreshold, cfg.BreakerTimeout, p.onBreakerChange)
+	}
+	return p
+}
+
+// IsCircuitOpen to check if requests to MyAnimeList are
+// currently rejected by the circuit breaker.
+func (p *Parser) IsCircuitOpen() bool {
+	return p.breaker.isOpen()
+}
+
+func (p *Parser) onBreakerChange(from, to string) {
+	if to == stateOpen {
+		p.logger.Error("circuit breaker %s -> %s", from, to)
+	} else {
+		p.logger.Info("circuit breaker %s -> %s", from, to)
+	}
+	p.observer.Inc(internal.MetricCircuitBreakerState, map[string]string{"from": from, "to": to})
 }
@@ -1,14 +1,21 @@
 package producermagazine
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/schedule"
 	"github.com/rl404/go-malscraper/pkg/utils"
 )
 
+// Airing start date with broadcast time.
+// Example: "Apr 3, 2021, 01:00 (JST)".
+var remainTimeRegex = regexp.MustCompile(`^(.+?),?\s+(\d{1,2}:\d{2})\s+\((\w+)\)$`)
+
 type producer struct {
 	area     *goquery.Selection
 	cleanImg bool
@@ -29,19 +36,20 @@ func (p *producer) setDetail() {
 		topArea := eachArea.Find("div.prodsrc")
 		infoArea := eachArea.Find(".information")
 		producers = append(producers, model.AnimeItem{
-			ID:        p.getID(nameArea),
-			Image:     p.getImage(eachArea),
-			Title:     p.getTitle(nameArea),
-			Genres:    p.getGenres(eachArea),
-			Synopsis:  p.getSynopsis(eachArea),
-			Source:    p.getSource(topArea),
-			Producers: p.getProducer(topArea),
-			Episode:   p.getProgress(topArea),
-			Licensors: p.getLicensors(eachArea),
-			Type:      p.getType(infoArea),
-			StartDate: p.getStartDate(infoArea),
-			Member:    p.getMember(infoArea),
-			Score:     p.getScore(infoArea),
+			ID:            p.getID(nameArea),
+			Image:         p.getImage(eachArea),
+			Title:         p.getTitle(nameArea),
+			Genres:        p.getGenres(eachArea),
+			Synopsis:      p.getSynopsis(eachArea),
+			Source:        p.getSource(topArea),
+			Producers:     p.getProducer(topArea),
+			Episode:       p.getProgress(topArea),
+			Licensors:     p.getLicensors(eachArea),
+			Type:          p.getType(infoArea),
+			StartDate:     p.getStartDate(infoArea),
+			BroadcastTime: p.getBroadcastTime(infoArea),
+			Member:        p.getMember(infoArea),
+			Score:         p.getScore(infoArea),
 		})
 	})
 	p.data = producers
@@ -85,8 +93,8 @@ func (p *producer) getSynopsis(eachArea *goquery.Selection) string {
 	return synopsis
 }
 
-func (p *producer) getSource(topArea *goquery.Selection) string {
-	return strings.TrimSpace(topArea.Find("span.source").Text())
+func (p *producer) getSource(topArea *goquery.Selection) model.AnimeSource {
+	return model.ParseAnimeSource(topArea.Find("span.source").Text())
 }
 
 func (p *producer) getProducer(area *goquery.Selection) []model.Item {
@@ -129,15 +137,36 @@ func (p *producer) getLicensors(eachArea *goquery.Selection) []string {
 	return licensors
 }
 
-func (p *producer) getType(area *goquery.Selection) string {
-	return utils.GetValueFromSplit(area.Find(".info").Text(), "-", 0)
+func (p *producer) getType(area *goquery.Selection) model.AnimeType {
+	return model.ParseAnimeType(utils.GetValueFromSplit(area.Find(".info").Text(), "-", 0))
 }
 
 func (p *producer) getStartDate(area *goquery.Selection) model.Date {
-	y, m, d := utils.StrToDate(area.Find(".info .remain-time").Text())
+	str := strings.TrimSpace(area.Find(".info .remain-time").Text())
+	if match := remainTimeRegex.FindStringSubmatch(str); len(match) > 0 {
+		str = match[1]
+	}
+	y, m, d := utils.StrToDate(str)
 	return model.Date{Year: y, Month: m, Day: d}
 }
 
+// getBroadcastTime to get weekly broadcast time from the airing
+// start date and time. The date is in the broadcast time zone.
+func (p *producer) getBroadcastTime(area *goquery.Selection) model.Broadcast {
+	match := remainTimeRegex.FindStringSubmatch(strings.TrimSpace(area.Find(".info .remain-time").Text()))
+	if len(match) == 0 {
+		return model.Broadcast{Unknown: true}
+	}
+
+	y, m, d := utils.StrToDate(match[1])
+	if y == 0 || m == 0 || d == 0 {
+		return model.Broadcast{Unknown: true}
+	}
+
+	day := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC).Weekday()
+	return schedule.ParseBroadcast(fmt.Sprintf("%ss at %s (%s)", day, match[2], match[3]))
+}
+
 func (p *producer) getMember(area *goquery.Selection) int {
 	return utils.StrToNum(area.Find(".scormem span[class^=member]").Text())
 }
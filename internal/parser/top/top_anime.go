@@ -61,8 +61,8 @@ func (a *anime) getTitle(nameArea *goquery.Selection) string {
 	return nameArea.Find("a").First().Text()
 }
 
-func (a *anime) getType(parsedInfo []string) string {
-	return strings.Split(strings.TrimSpace(parsedInfo[0]), " ")[0]
+func (a *anime) getType(parsedInfo []string) model.AnimeType {
+	return model.ParseAnimeType(strings.Split(strings.TrimSpace(parsedInfo[0]), " ")[0])
 }
 
 func (a *anime) getEpCh(parsedInfo []string) int {
@@ -0,0 +1,231 @@
+package parser
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/utils"
+)
+
+// Available sub-pages for each entity. Empty sub-page means
+// the entity's main (details) page.
+var subPages = map[string][]string{
+	"anime":       {"", "characters", "video", "episode", "stats", "reviews", "userrecs", "news", "featured", "clubs", "pics", "moreinfo"},
+	"manga":       {"", "characters", "stats", "reviews", "userrecs", "news", "featured", "clubs", "pics", "moreinfo"},
+	"character":   {"", "featured", "pictures", "clubs"},
+	"people":      {"", "news", "featured", "pictures"},
+	"user":        {"", "friends", "reviews", "recommendations", "clubs", "history", "animelist", "mangalist"},
+	"club":        {"", "members", "pictures"},
+	"news":        {""},
+	"article":     {""},
+	"review":      {""},
+	"producer":    {""},
+	"magazine":    {""},
+	"anime-genre": {""},
+	"manga-genre": {""},
+}
+
+type pageParam struct {
+	name  string
+	limit int // item count per page if using offset instead of page number
+}
+
+// Page query param for each entity & sub-page. Default is `p`.
+var pageParams = map[string]pageParam{
+	"anime:episode": {name: "offset", limit: 100},
+	"user:friends":  {name: "offset", limit: 100},
+	"club:members":  {name: "show", limit: 36},
+	"producer:":     {name: "page"},
+	"magazine:":     {name: "page"},
+	"anime-genre:":  {name: "page"},
+	"manga-genre:":  {name: "page"},
+}
+
+// URLFor to build MyAnimeList URL of the entity.
+// The id should be a username (string) for user entity,
+// and an int for the others.
+func URLFor(entity string, id interface{}, subPage string) (string, error) {
+	if !isValidSubPage(entity, subPage) {
+		return "", errors.ErrInvalidURL
+	}
+
+	if entity == "user" {
+		user, ok := id.(string)
+		if !ok || user == "" {
+			return "", errors.ErrInvalidUsername
+		}
+		switch subPage {
+		case "":
+			return utils.BuildURL(malURL, "profile", user), nil
+		case "history", "animelist", "mangalist":
+			return utils.BuildURL(malURL, subPage, user), nil
+		default:
+			return utils.BuildURL(malURL, "profile", user, subPage), nil
+		}
+	}
+
+	i, ok := id.(int)
+	if !ok || i <= 0 {
+		return "", errors.ErrInvalidID
+	}
+
+	switch entity {
+	case "anime", "manga", "character", "people":
+		if subPage == "" {
+			return utils.BuildURL(malURL, entity, i), nil
+		}
+		return utils.BuildURL(malURL, entity, i, "a", subPage), nil
+	case "club":
+		if subPage == "" {
+			return utils.BuildURLWithQuery(map[string]interface{}{"cid": i}, malURL, "clubs.php"), nil
+		}
+		return utils.BuildURLWithQuery(map[string]interface{}{"id": i, "action": "view", "t": subPage}, malURL, "clubs.php"), nil
+	case "news":
+		return utils.BuildURL(malURL, "news", i), nil
+	case "article":
+		return utils.BuildURL(malURL, "featured", i), nil
+	case "review":
+		return utils.BuildURLWithQuery(map[string]interface{}{"id": i}, malURL, "reviews.php"), nil
+	case "producer":
+		return utils.BuildURL(malURL, "anime", "producer", i), nil
+	case "magazine":
+		return utils.BuildURL(malURL, "manga", "magazine", i), nil
+	case "anime-genre":
+		return utils.BuildURL(malURL, "anime", "genre", i), nil
+	case "manga-genre":
+		return utils.BuildURL(malURL, "manga", "genre", i), nil
+	}
+
+	return "", errors.ErrInvalidURL
+}
+
+// ParseURL to parse MyAnimeList URL to get its entity, id/username,
+// sub-page and page number. Relative URL (path only) is also accepted.
+func ParseURL(str string) (*model.URLInfo, error) {
+	u, err := url.Parse(strings.TrimSpace(str))
+	if err != nil {
+		return nil, errors.ErrInvalidURL
+	}
+
+	path := u.Path
+	if u.Host == "" {
+		// Without scheme, host is parsed as part of the path.
+		path = strings.TrimPrefix(strings.TrimPrefix(path, "www."), "myanimelist.net")
+	} else if host := strings.TrimPrefix(u.Hostname(), "www."); host != "myanimelist.net" {
+		return nil, errors.ErrInvalidURL
+	}
+
+	var dirs []string
+	for _, d := range strings.Split(path, "/") {
+		if d != "" {
+			dirs = append(dirs, d)
+		}
+	}
+
+	if len(dirs) == 0 {
+		return nil, errors.ErrInvalidURL
+	}
+
+	info := model.URLInfo{}
+	q := u.Query()
+
+	switch dirs[0] {
+	case "anime", "manga":
+		if len(dirs) < 2 {
+			return nil, errors.ErrInvalidURL
+		}
+		switch dirs[1] {
+		case "genre":
+			info.Entity, info.ID = dirs[0]+"-genre", utils.StrToNum(getDir(dirs, 2))
+		case "producer", "magazine":
+			info.Entity, info.ID = dirs[1], utils.StrToNum(getDir(dirs, 2))
+		default:
+			info.Entity, info.ID, info.SubPage = dirs[0], utils.StrToNum(dirs[1]), getSubPage(dirs[0], dirs)
+		}
+	case "character", "people":
+		if len(dirs) < 2 {
+			return nil, errors.ErrInvalidURL
+		}
+		info.Entity, info.ID, info.SubPage = dirs[0], utils.StrToNum(dirs[1]), getSubPage(dirs[0], dirs)
+	case "profile":
+		info.Entity, info.Username, info.SubPage = "user", getDir(dirs, 1), getDir(dirs, 2)
+	case "history", "animelist", "mangalist":
+		info.Entity, info.Username, info.SubPage = "user", getDir(dirs, 1), dirs[0]
+	case "news":
+		info.Entity, info.ID = "news", utils.StrToNum(getDir(dirs, 1))
+	case "featured":
+		info.Entity, info.ID = "article", utils.StrToNum(getDir(dirs, 1))
+	case "reviews.php":
+		info.Entity, info.ID = "review", utils.StrToNum(q.Get("id"))
+	case "clubs.php":
+		info.Entity = "club"
+		if q.Get("cid") != "" {
+			info.ID = utils.StrToNum(q.Get("cid"))
+		} else {
+			info.ID, info.SubPage = utils.StrToNum(q.Get("id")), q.Get("t")
+		}
+	default:
+		return nil, errors.ErrInvalidURL
+	}
+
+	if info.Entity == "user" && info.Username == "" {
+		return nil, errors.ErrInvalidUsername
+	}
+
+	if info.Entity != "user" && info.ID <= 0 {
+		return nil, errors.ErrInvalidID
+	}
+
+	if !isValidSubPage(info.Entity, info.SubPage) {
+		return nil, errors.ErrInvalidURL
+	}
+
+	info.Page = getPage(info.Entity+":"+info.SubPage, q)
+
+	return &info, nil
+}
+
+func isValidSubPage(entity, subPage string) bool {
+	for _, s := range subPages[entity] {
+		if s == subPage {
+			return true
+		}
+	}
+	return false
+}
+
+// getSubPage to get sub-page of anime, manga, character, and people
+// URL. The sub-page is after the slug (`/anime/1/Cowboy_Bebop/reviews`)
+// but the slug may be omitted (`/anime/1/reviews`).
+func getSubPage(entity string, dirs []string) string {
+	if len(dirs) == 3 && dirs[2] != "" && isValidSubPage(entity, dirs[2]) {
+		return dirs[2]
+	}
+	return getDir(dirs, 3)
+}
+
+func getDir(dirs []string, i int) string {
+	if i >= len(dirs) {
+		return ""
+	}
+	return dirs[i]
+}
+
+func getPage(key string, q url.Values) int {
+	param, ok := pageParams[key]
+	if !ok {
+		param.name = "p"
+	}
+
+	if param.limit > 0 {
+		return 1 + utils.StrToNum(q.Get(param.name))/param.limit
+	}
+
+	if page := utils.StrToNum(q.Get(param.name)); page > 0 {
+		return page
+	}
+
+	return 1
+}
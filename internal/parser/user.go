@@ -111,8 +111,12 @@ func (p *Parser) GetUserAnime(query model.UserListQuery) ([]model.UserAnime, int
 	for {
 		// Get body response.
 		q["offset"] = offset
-		body, code, err := p.getBody(utils.BuildURLWithQuery(q, malURL, "animelist", query.Username, "load.json"))
+		url := utils.BuildURLWithQuery(q, malURL, "animelist", query.Username, "load.json")
+		body, code, err := p.getBody(url)
 		if err != nil {
+			if err == errors.ErrDryRun && query.Page == -1 {
+				p.planner.Estimate(url)
+			}
 			return nil, code, err
 		}
 
@@ -160,8 +164,12 @@ func (p *Parser) GetUserManga(query model.UserListQuery) ([]model.UserManga, int
 	for {
 		// Get body response.
 		q["offset"] = offset
-		body, code, err := p.getBody(utils.BuildURLWithQuery(q, malURL, "mangalist", query.Username, "load.json"))
+		url := utils.BuildURLWithQuery(q, malURL, "mangalist", query.Username, "load.json")
+		body, code, err := p.getBody(url)
 		if err != nil {
+			if err == errors.ErrDryRun && query.Page == -1 {
+				p.planner.Estimate(url)
+			}
 			return nil, code, err
 		}
 
@@ -29,11 +29,11 @@ func (a *anime) setDetails() {
 			Image:        utils.URLCleaner(r.AnimeImage, "image", a.cleanImg),
 			Score:        r.Score,
 			Status:       r.Status,
-			Type:         r.AnimeType,
+			Type:         model.ParseAnimeType(r.AnimeType),
 			Progress:     r.WatchedEpisode,
 			Episode:      r.AnimeEpisode,
 			Tag:          fmt.Sprintf("%v", r.Tags),
-			Rating:       r.AnimeRating,
+			Rating:       model.ParseAnimeRating(r.AnimeRating),
 			AiringStatus: r.AnimeAiringStatus,
 			IsRewatching: a.getIsRewatching(r.IsRewatching),
 			Days:         r.Days,
@@ -0,0 +1,149 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rl404/go-malscraper/model"
+)
+
+// Planner records MyAnimeList requests which would be
+// sent and cache usage in dry-run mode.
+type Planner struct {
+	sync.Mutex
+	requests []model.PlanRequest
+	urls     map[string]int // index in requests
+	hits     map[string]map[string]bool
+	misses   map[string]map[string]bool
+}
+
+// NewPlanner to create new empty planner.
+func NewPlanner() *Planner {
+	p := &Planner{}
+	p.Reset()
+	return p
+}
+
+// Reset to clear all recorded requests and cache usage.
+func (p *Planner) Reset() {
+	p.Lock()
+	defer p.Unlock()
+	p.requests = []model.PlanRequest{}
+	p.urls = make(map[string]int)
+	p.hits = make(map[string]map[string]bool)
+	p.misses = make(map[string]map[string]bool)
+}
+
+// AddRequest to record a request. Same URL is only recorded once.
+func (p *Planner) AddRequest(url string) {
+	p.Lock()
+	defer p.Unlock()
+	if _, ok := p.urls[url]; ok {
+		return
+	}
+	p.urls[url] = len(p.requests)
+	p.requests = append(p.requests, model.PlanRequest{URL: url})
+}
+
+// Estimate to mark recorded request as the first page of
+// multi-page request.
+func (p *Planner) Estimate(url string) {
+	p.Lock()
+	defer p.Unlock()
+	if i, ok := p.urls[url]; ok {
+		p.requests[i].Estimated = true
+	}
+}
+
+// EstimatePages to set the estimated page count of the `n`-th
+// recorded request if it is the first page of multi-page request.
+func (p *Planner) EstimatePages(n, pages int) {
+	p.Lock()
+	defer p.Unlock()
+	if n < len(p.requests) && p.requests[n].Estimated {
+		p.requests[n].Pages = pages
+	}
+}
+
+// Len to get the number of recorded requests.
+func (p *Planner) Len() int {
+	p.Lock()
+	defer p.Unlock()
+	return len(p.requests)
+}
+
+// EstimateFrom to mark requests recorded after the first
+// `n` requests as estimated. Used when the number of the
+// following requests depends on the not requested ones.
+func (p *Planner) EstimateFrom(n int) {
+	p.Lock()
+	defer p.Unlock()
+	for i := n; i < len(p.requests); i++ {
+		p.requests[i].Estimated = true
+	}
+}
+
+// AddHit to record cache key which is found in cache.
+func (p *Planner) AddHit(key string) {
+	p.addKey(p.hits, key)
+}
+
+// AddMiss to record cache key which is not found in cache.
+func (p *Planner) AddMiss(key string) {
+	p.addKey(p.misses, key)
+}
+
+func (p *Planner) addKey(m map[string]map[string]bool, key string) {
+	prefix := getKeyPrefix(key)
+	p.Lock()
+	defer p.Unlock()
+	if m[prefix] == nil {
+		m[prefix] = make(map[string]bool)
+	}
+	m[prefix][key] = true
+}
+
+// Plan to get recorded requests and cache usage. Cache
+// usage is sorted by key prefix.
+func (p *Planner) Plan() model.Plan {
+	p.Lock()
+	defer p.Unlock()
+
+	plan := model.Plan{
+		Requests: make([]model.PlanRequest, len(p.requests)),
+		Keys:     []model.PlanKey{},
+	}
+	copy(plan.Requests, p.requests)
+
+	prefixes := make(map[string]bool)
+	for prefix := range p.hits {
+		prefixes[prefix] = true
+	}
+	for prefix := range p.misses {
+		prefixes[prefix] = true
+	}
+
+	for prefix := range prefixes {
+		plan.Keys = append(plan.Keys, model.PlanKey{
+			Prefix: prefix,
+			Hit:    len(p.hits[prefix]),
+			Miss:   len(p.misses[prefix]),
+		})
+	}
+	sort.Slice(plan.Keys, func(i, j int) bool {
+		return plan.Keys[i].Prefix < plan.Keys[j].Prefix
+	})
+
+	return plan
+}
+
+// getKeyPrefix to get key without its params.
+// For example, `mal:anime:1` to `mal:anime`.
+func getKeyPrefix(key string) string {
+	split := strings.SplitN(key, ":", 3)
+	if len(split) < 3 {
+		return key
+	}
+	return split[0] + ":" + split[1]
+}
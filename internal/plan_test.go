@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanner(t *testing.T) {
+	p := NewPlanner()
+	p.AddRequest("url1")
+	p.AddRequest("url2")
+	p.AddRequest("url1")
+	p.Estimate("url2")
+	p.Estimate("url3")
+	p.AddHit(GetKey(KeyUser, "rl404"))
+	p.AddMiss(GetKey(KeyAnime, 1))
+	p.AddMiss(GetKey(KeyAnime, 1))
+	p.AddMiss(GetKey(KeyAnime, 2))
+
+	assert.Equal(t, model.Plan{
+		Requests: []model.PlanRequest{
+			{URL: "url1"},
+			{URL: "url2", Estimated: true},
+		},
+		Keys: []model.PlanKey{
+			{Prefix: KeyAnime, Miss: 2},
+			{Prefix: KeyUser, Hit: 1},
+		},
+	}, p.Plan())
+
+	n := p.Len()
+	assert.Equal(t, 2, n)
+	p.AddRequest("url3")
+	p.AddRequest("url4")
+	p.EstimateFrom(n)
+	p.EstimatePages(0, 3)
+	p.EstimatePages(1, 3)
+	p.EstimatePages(9, 3)
+	assert.Equal(t, []model.PlanRequest{
+		{URL: "url1"},
+		{URL: "url2", Estimated: true, Pages: 3},
+		{URL: "url3", Estimated: true},
+		{URL: "url4", Estimated: true},
+	}, p.Plan().Requests)
+
+	p.Reset()
+	assert.Empty(t, p.Plan().Requests)
+	assert.Empty(t, p.Plan().Keys)
+}
+
+func TestGetKeyPrefix(t *testing.T) {
+	assert.Equal(t, KeyAnime, getKeyPrefix(GetKey(KeyAnime, 1)))
+	assert.Equal(t, KeySearchAnime, getKeyPrefix(GetKey(KeySearchAnime, "a:b", 1)))
+	assert.Equal(t, KeyAnime, getKeyPrefix(KeyAnime))
+}
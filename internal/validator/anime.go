@@ -16,8 +16,8 @@ func (v *Validator) GetAnime(id int) (*model.Anime, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -37,8 +37,8 @@ func (v *Validator) GetAnimeCharacter(id int) ([]model.CharacterItem, int, error
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -58,8 +58,8 @@ func (v *Validator) GetAnimeStaff(id int) ([]model.Role, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -82,8 +82,8 @@ func (v *Validator) GetAnimeVideo(id int, page int) (*model.Video, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -106,8 +106,8 @@ func (v *Validator) GetAnimeEpisode(id int, page int) ([]model.Episode, int, err
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -127,8 +127,8 @@ func (v *Validator) GetAnimeStats(id int) (*model.Stats, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -151,8 +151,8 @@ func (v *Validator) GetAnimeReview(id int, page int) ([]model.Review, int, error
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -172,8 +172,8 @@ func (v *Validator) GetAnimeRecommendation(id int) ([]model.Recommendation, int,
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -193,8 +193,8 @@ func (v *Validator) GetAnimeNews(id int) ([]model.NewsItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -214,8 +214,8 @@ func (v *Validator) GetAnimeArticle(id int) ([]model.ArticleItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -235,8 +235,8 @@ func (v *Validator) GetAnimeClub(id int) ([]model.ClubItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -256,8 +256,8 @@ func (v *Validator) GetAnimePicture(id int) ([]string, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -277,8 +277,8 @@ func (v *Validator) GetAnimeMoreInfo(id int) (string, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyAnime, id)
-	if v.isEmptyID(key) {
-		return "", http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return "", code, errors.ErrNot200
 	}
 
 	// Parse.
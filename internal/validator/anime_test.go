@@ -13,13 +13,13 @@ import (
 )
 
 func TestGetAnime(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnime(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -29,10 +29,10 @@ func TestGetAnime(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnime(1)
 		assert.Nil(t, d)
@@ -45,7 +45,7 @@ func TestGetAnime(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnime", 1).Return(&model.Anime{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnime(1)
 		assert.NotNil(t, d)
@@ -55,13 +55,13 @@ func TestGetAnime(t *testing.T) {
 }
 
 func TestGetAnimeCharacter(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeCharacter(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -71,10 +71,10 @@ func TestGetAnimeCharacter(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeCharacter(1)
 		assert.Nil(t, d)
@@ -87,7 +87,7 @@ func TestGetAnimeCharacter(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeCharacter", 1).Return([]model.CharacterItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeCharacter(1)
 		assert.NotNil(t, d)
@@ -97,13 +97,13 @@ func TestGetAnimeCharacter(t *testing.T) {
 }
 
 func TestGetAnimeStaff(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeStaff(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -113,10 +113,10 @@ func TestGetAnimeStaff(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeStaff(1)
 		assert.Nil(t, d)
@@ -129,7 +129,7 @@ func TestGetAnimeStaff(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeStaff", 1).Return([]model.Role{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeStaff(1)
 		assert.NotNil(t, d)
@@ -139,13 +139,13 @@ func TestGetAnimeStaff(t *testing.T) {
 }
 
 func TestGetAnimeVideo(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeVideo(0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -154,7 +154,7 @@ func TestGetAnimeVideo(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeVideo(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -164,10 +164,10 @@ func TestGetAnimeVideo(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeVideo(1, 1)
 		assert.Nil(t, d)
@@ -180,7 +180,7 @@ func TestGetAnimeVideo(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeVideo", 1, 1).Return(&model.Video{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeVideo(1, 1)
 		assert.NotNil(t, d)
@@ -190,13 +190,13 @@ func TestGetAnimeVideo(t *testing.T) {
 }
 
 func TestGetAnimeEpisode(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeEpisode(0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -205,7 +205,7 @@ func TestGetAnimeEpisode(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeEpisode(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -215,10 +215,10 @@ func TestGetAnimeEpisode(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeEpisode(1, 1)
 		assert.Nil(t, d)
@@ -231,7 +231,7 @@ func TestGetAnimeEpisode(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeEpisode", 1, 1).Return([]model.Episode{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeEpisode(1, 1)
 		assert.NotNil(t, d)
@@ -241,13 +241,13 @@ func TestGetAnimeEpisode(t *testing.T) {
 }
 
 func TestGetAnimeStats(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeStats(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -257,10 +257,10 @@ func TestGetAnimeStats(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeStats(1)
 		assert.Nil(t, d)
@@ -273,7 +273,7 @@ func TestGetAnimeStats(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeStats", 1).Return(&model.Stats{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeStats(1)
 		assert.NotNil(t, d)
@@ -283,13 +283,13 @@ func TestGetAnimeStats(t *testing.T) {
 }
 
 func TestGetAnimeReview(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeReview(0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -298,7 +298,7 @@ func TestGetAnimeReview(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeReview(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -308,10 +308,10 @@ func TestGetAnimeReview(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeReview(1, 1)
 		assert.Nil(t, d)
@@ -324,7 +324,7 @@ func TestGetAnimeReview(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeReview", 1, 1).Return([]model.Review{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeReview(1, 1)
 		assert.NotNil(t, d)
@@ -334,13 +334,13 @@ func TestGetAnimeReview(t *testing.T) {
 }
 
 func TestGetAnimeRecommendation(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeRecommendation(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -350,10 +350,10 @@ func TestGetAnimeRecommendation(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeRecommendation(1)
 		assert.Nil(t, d)
@@ -366,7 +366,7 @@ func TestGetAnimeRecommendation(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeRecommendation", 1).Return([]model.Recommendation{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeRecommendation(1)
 		assert.NotNil(t, d)
@@ -376,13 +376,13 @@ func TestGetAnimeRecommendation(t *testing.T) {
 }
 
 func TestGetAnimeNews(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeNews(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -392,10 +392,10 @@ func TestGetAnimeNews(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeNews(1)
 		assert.Nil(t, d)
@@ -408,7 +408,7 @@ func TestGetAnimeNews(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeNews", 1).Return([]model.NewsItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeNews(1)
 		assert.NotNil(t, d)
@@ -418,13 +418,13 @@ func TestGetAnimeNews(t *testing.T) {
 }
 
 func TestGetAnimeArticles(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeArticle(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -434,10 +434,10 @@ func TestGetAnimeArticles(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeArticle(1)
 		assert.Nil(t, d)
@@ -450,7 +450,7 @@ func TestGetAnimeArticles(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeArticle", 1).Return([]model.ArticleItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeArticle(1)
 		assert.NotNil(t, d)
@@ -460,13 +460,13 @@ func TestGetAnimeArticles(t *testing.T) {
 }
 
 func TestGetAnimeClub(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeClub(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -476,10 +476,10 @@ func TestGetAnimeClub(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeClub(1)
 		assert.Nil(t, d)
@@ -492,7 +492,7 @@ func TestGetAnimeClub(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeClub", 1).Return([]model.ClubItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeClub(1)
 		assert.NotNil(t, d)
@@ -502,13 +502,13 @@ func TestGetAnimeClub(t *testing.T) {
 }
 
 func TestGetAnimePicture(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimePicture(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -518,10 +518,10 @@ func TestGetAnimePicture(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimePicture(1)
 		assert.Nil(t, d)
@@ -534,7 +534,7 @@ func TestGetAnimePicture(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimePicture", 1).Return([]string{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimePicture(1)
 		assert.NotNil(t, d)
@@ -544,13 +544,13 @@ func TestGetAnimePicture(t *testing.T) {
 }
 
 func TestGetAnimeMoreInfo(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeMoreInfo(0)
 		assert.Empty(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -560,10 +560,10 @@ func TestGetAnimeMoreInfo(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeMoreInfo(1)
 		assert.Empty(t, d)
@@ -576,7 +576,7 @@ func TestGetAnimeMoreInfo(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetAnimeMoreInfo", 1).Return("info", http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:anime:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeMoreInfo(1)
 		assert.NotEmpty(t, d)
@@ -13,13 +13,13 @@ import (
 )
 
 func TestGetArticle(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetArticle(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -29,10 +29,10 @@ func TestGetArticle(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:article:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetArticle(1)
 		assert.Nil(t, d)
@@ -45,7 +45,7 @@ func TestGetArticle(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:article:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetArticle", 1).Return(&model.Article{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:article:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetArticle(1)
 		assert.NotNil(t, d)
@@ -61,7 +61,7 @@ func TestGetArticles(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetArticles(0, "")
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -74,7 +74,7 @@ func TestGetArticles(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ArticleTagItem)
 			*tmp = []model.ArticleTagItem{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetArticles(1, "tag")
 		assert.Nil(t, d)
@@ -85,7 +85,7 @@ func TestGetArticles(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("GetArticles", 1, "").Return([]model.ArticleItem{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetArticles(1, "")
 		assert.NotNil(t, d)
@@ -100,7 +100,7 @@ func TestGetArticleTag(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	mockAPI.On("GetArticleTag").Return([]model.ArticleTagItem{}, http.StatusOK, nil).Once()
-	v := New(mockAPI, mockCacher, mockLogger)
+	v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 	d, code, err := v.GetArticleTag()
 	assert.NotNil(t, d)
@@ -16,8 +16,8 @@ func (v *Validator) GetCharacter(id int) (*model.Character, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyChar, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -37,8 +37,8 @@ func (v *Validator) GetCharacterArticle(id int) ([]model.ArticleItem, int, error
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyChar, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -61,8 +61,8 @@ func (v *Validator) GetCharacterOgraphy(t string, id int) ([]model.Role, int, er
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyChar, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -82,8 +82,8 @@ func (v *Validator) GetCharacterPicture(id int) ([]string, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyChar, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -103,8 +103,8 @@ func (v *Validator) GetCharacterClub(id int) ([]model.ClubItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyChar, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -124,8 +124,8 @@ func (v *Validator) GetCharacterVA(id int) ([]model.Role, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyChar, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
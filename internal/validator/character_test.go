@@ -13,13 +13,13 @@ import (
 )
 
 func TestGetCharacter(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetCharacter(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -29,10 +29,10 @@ func TestGetCharacter(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacter(1)
 		assert.Nil(t, d)
@@ -45,7 +45,7 @@ func TestGetCharacter(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetCharacter", 1).Return(&model.Character{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:character:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacter(1)
 		assert.NotNil(t, d)
@@ -55,13 +55,13 @@ func TestGetCharacter(t *testing.T) {
 }
 
 func TestGetCharacterArticle(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetCharacterArticle(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -71,10 +71,10 @@ func TestGetCharacterArticle(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterArticle(1)
 		assert.Nil(t, d)
@@ -87,7 +87,7 @@ func TestGetCharacterArticle(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetCharacterArticle", 1).Return([]model.ArticleItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:character:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterArticle(1)
 		assert.NotNil(t, d)
@@ -97,13 +97,13 @@ func TestGetCharacterArticle(t *testing.T) {
 }
 
 func TestGetCharacterOgraphy(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-type", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetCharacterOgraphy("", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -112,7 +112,7 @@ func TestGetCharacterOgraphy(t *testing.T) {
 	})
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetCharacterOgraphy(AnimeType, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -122,10 +122,10 @@ func TestGetCharacterOgraphy(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterOgraphy(AnimeType, 1)
 		assert.Nil(t, d)
@@ -138,7 +138,7 @@ func TestGetCharacterOgraphy(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetCharacterOgraphy", "anime", 1).Return([]model.Role{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:character:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterOgraphy(AnimeType, 1)
 		assert.NotNil(t, d)
@@ -148,13 +148,13 @@ func TestGetCharacterOgraphy(t *testing.T) {
 }
 
 func TestGetCharacterPicture(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetCharacterPicture(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -164,10 +164,10 @@ func TestGetCharacterPicture(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterPicture(1)
 		assert.Nil(t, d)
@@ -180,7 +180,7 @@ func TestGetCharacterPicture(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetCharacterPicture", 1).Return([]string{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:character:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterPicture(1)
 		assert.NotNil(t, d)
@@ -190,13 +190,13 @@ func TestGetCharacterPicture(t *testing.T) {
 }
 
 func TestGetCharacterClub(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetCharacterClub(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -206,10 +206,10 @@ func TestGetCharacterClub(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterClub(1)
 		assert.Nil(t, d)
@@ -222,7 +222,7 @@ func TestGetCharacterClub(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetCharacterClub", 1).Return([]model.ClubItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:character:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterClub(1)
 		assert.NotNil(t, d)
@@ -232,13 +232,13 @@ func TestGetCharacterClub(t *testing.T) {
 }
 
 func TestGetCharacterVA(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetCharacterVA(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -248,10 +248,10 @@ func TestGetCharacterVA(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterVA(1)
 		assert.Nil(t, d)
@@ -264,7 +264,7 @@ func TestGetCharacterVA(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:character:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetCharacterVA", 1).Return([]model.Role{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:character:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetCharacterVA(1)
 		assert.NotNil(t, d)
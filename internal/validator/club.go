@@ -24,8 +24,8 @@ func (v *Validator) GetClub(id int) (*model.Club, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyClub, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -48,8 +48,8 @@ func (v *Validator) GetClubMember(id int, page int) ([]model.ClubMember, int, er
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyClub, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -69,8 +69,8 @@ func (v *Validator) GetClubPicture(id int) ([]string, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyClub, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -90,8 +90,8 @@ func (v *Validator) GetClubRelated(id int) (*model.ClubRelated, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyClub, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -18,7 +18,7 @@ func TestGetClubs(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetClubs(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -28,7 +28,7 @@ func TestGetClubs(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("GetClubs", 1).Return([]model.ClubSearch{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClubs(1)
 		assert.NotNil(t, d)
@@ -38,13 +38,13 @@ func TestGetClubs(t *testing.T) {
 }
 
 func TestGetClub(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetClub(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -54,10 +54,10 @@ func TestGetClub(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:club:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClub(1)
 		assert.Nil(t, d)
@@ -70,7 +70,7 @@ func TestGetClub(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:club:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetClub", 1).Return(&model.Club{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:club:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClub(1)
 		assert.NotNil(t, d)
@@ -80,13 +80,13 @@ func TestGetClub(t *testing.T) {
 }
 
 func TestGetClubMember(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetClubMember(0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -95,7 +95,7 @@ func TestGetClubMember(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetClubMember(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -105,10 +105,10 @@ func TestGetClubMember(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:club:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClubMember(1, 1)
 		assert.Nil(t, d)
@@ -121,7 +121,7 @@ func TestGetClubMember(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:club:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetClubMember", 1, 1).Return([]model.ClubMember{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:club:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClubMember(1, 1)
 		assert.NotNil(t, d)
@@ -131,13 +131,13 @@ func TestGetClubMember(t *testing.T) {
 }
 
 func TestGetClubPicture(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetClubPicture(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -147,10 +147,10 @@ func TestGetClubPicture(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:club:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClubPicture(1)
 		assert.Nil(t, d)
@@ -163,7 +163,7 @@ func TestGetClubPicture(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:club:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetClubPicture", 1).Return([]string{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:club:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClubPicture(1)
 		assert.NotNil(t, d)
@@ -173,13 +173,13 @@ func TestGetClubPicture(t *testing.T) {
 }
 
 func TestGetClubRelated(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetClubRelated(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -189,10 +189,10 @@ func TestGetClubRelated(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:club:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClubRelated(1)
 		assert.Nil(t, d)
@@ -205,7 +205,7 @@ func TestGetClubRelated(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:club:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetClubRelated", 1).Return(&model.ClubRelated{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:club:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetClubRelated(1)
 		assert.NotNil(t, d)
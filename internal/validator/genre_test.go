@@ -18,7 +18,7 @@ func TestGetGenres(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-type", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetGenres("")
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -28,7 +28,7 @@ func TestGetGenres(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("GetGenres", AnimeType).Return([]model.ItemCount{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetGenres(AnimeType)
 		assert.NotNil(t, d)
@@ -44,7 +44,7 @@ func TestGetAnimeWithGenre(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetAnimeWithGenre(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -57,7 +57,7 @@ func TestGetAnimeWithGenre(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ItemCount)
 			*tmp = []model.ItemCount{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeWithGenre(1, 1)
 		assert.Nil(t, d)
@@ -70,7 +70,7 @@ func TestGetAnimeWithGenre(t *testing.T) {
 		mockCacher.On("Get", "mal:genres:anime", &genres).Return(errDummy).Once()
 		mockAPI.On("GetAnimeWithGenre", 1, 1).Return([]model.AnimeItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:genres:anime", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetAnimeWithGenre(1, 1)
 		assert.NotNil(t, d)
@@ -86,7 +86,7 @@ func TestGetMangaWithGenre(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaWithGenre(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -99,7 +99,7 @@ func TestGetMangaWithGenre(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ItemCount)
 			*tmp = []model.ItemCount{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaWithGenre(1, 1)
 		assert.Nil(t, d)
@@ -112,7 +112,7 @@ func TestGetMangaWithGenre(t *testing.T) {
 		mockCacher.On("Get", "mal:genres:manga", &genres).Return(errDummy).Once()
 		mockAPI.On("GetMangaWithGenre", 1, 1).Return([]model.MangaItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:genres:manga", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaWithGenre(1, 1)
 		assert.NotNil(t, d)
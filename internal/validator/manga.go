@@ -16,8 +16,8 @@ func (v *Validator) GetManga(id int) (*model.Manga, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -40,8 +40,8 @@ func (v *Validator) GetMangaReview(id int, page int) ([]model.Review, int, error
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -61,8 +61,8 @@ func (v *Validator) GetMangaRecommendation(id int) ([]model.Recommendation, int,
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -82,8 +82,8 @@ func (v *Validator) GetMangaStats(id int) (*model.Stats, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -103,8 +103,8 @@ func (v *Validator) GetMangaCharacter(id int) ([]model.Role, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -124,8 +124,8 @@ func (v *Validator) GetMangaNews(id int) ([]model.NewsItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -145,8 +145,8 @@ func (v *Validator) GetMangaArticle(id int) ([]model.ArticleItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -166,8 +166,8 @@ func (v *Validator) GetMangaClub(id int) ([]model.ClubItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -187,8 +187,8 @@ func (v *Validator) GetMangaPicture(id int) ([]string, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -208,8 +208,8 @@ func (v *Validator) GetMangaMoreInfo(id int) (string, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyManga, id)
-	if v.isEmptyID(key) {
-		return "", http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return "", code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -13,13 +13,13 @@ import (
 )
 
 func TestGetManga(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetManga(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -29,10 +29,10 @@ func TestGetManga(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetManga(1)
 		assert.Nil(t, d)
@@ -45,7 +45,7 @@ func TestGetManga(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetManga", 1).Return(&model.Manga{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetManga(1)
 		assert.NotNil(t, d)
@@ -55,13 +55,13 @@ func TestGetManga(t *testing.T) {
 }
 
 func TestGetMangaReview(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaReview(0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -70,7 +70,7 @@ func TestGetMangaReview(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaReview(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -80,10 +80,10 @@ func TestGetMangaReview(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaReview(1, 1)
 		assert.Nil(t, d)
@@ -96,7 +96,7 @@ func TestGetMangaReview(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaReview", 1, 1).Return([]model.Review{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaReview(1, 1)
 		assert.NotNil(t, d)
@@ -106,13 +106,13 @@ func TestGetMangaReview(t *testing.T) {
 }
 
 func TestGetMangaRecommendation(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaRecommendation(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -122,10 +122,10 @@ func TestGetMangaRecommendation(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaRecommendation(1)
 		assert.Nil(t, d)
@@ -138,7 +138,7 @@ func TestGetMangaRecommendation(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaRecommendation", 1).Return([]model.Recommendation{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaRecommendation(1)
 		assert.NotNil(t, d)
@@ -148,13 +148,13 @@ func TestGetMangaRecommendation(t *testing.T) {
 }
 
 func TestGetMangaStats(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaStats(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -164,10 +164,10 @@ func TestGetMangaStats(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaStats(1)
 		assert.Nil(t, d)
@@ -180,7 +180,7 @@ func TestGetMangaStats(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaStats", 1).Return(&model.Stats{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaStats(1)
 		assert.NotNil(t, d)
@@ -190,13 +190,13 @@ func TestGetMangaStats(t *testing.T) {
 }
 
 func TestGetMangaCharacter(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaCharacter(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -206,10 +206,10 @@ func TestGetMangaCharacter(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaCharacter(1)
 		assert.Nil(t, d)
@@ -222,7 +222,7 @@ func TestGetMangaCharacter(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaCharacter", 1).Return([]model.Role{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaCharacter(1)
 		assert.NotNil(t, d)
@@ -232,13 +232,13 @@ func TestGetMangaCharacter(t *testing.T) {
 }
 
 func TestGetMangaNews(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaNews(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -248,10 +248,10 @@ func TestGetMangaNews(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaNews(1)
 		assert.Nil(t, d)
@@ -264,7 +264,7 @@ func TestGetMangaNews(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaNews", 1).Return([]model.NewsItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaNews(1)
 		assert.NotNil(t, d)
@@ -274,13 +274,13 @@ func TestGetMangaNews(t *testing.T) {
 }
 
 func TestGetMangaArticles(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaArticle(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -290,10 +290,10 @@ func TestGetMangaArticles(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaArticle(1)
 		assert.Nil(t, d)
@@ -306,7 +306,7 @@ func TestGetMangaArticles(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaArticle", 1).Return([]model.ArticleItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaArticle(1)
 		assert.NotNil(t, d)
@@ -316,13 +316,13 @@ func TestGetMangaArticles(t *testing.T) {
 }
 
 func TestGetMangaClub(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaClub(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -332,10 +332,10 @@ func TestGetMangaClub(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaClub(1)
 		assert.Nil(t, d)
@@ -348,7 +348,7 @@ func TestGetMangaClub(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaClub", 1).Return([]model.ClubItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaClub(1)
 		assert.NotNil(t, d)
@@ -358,13 +358,13 @@ func TestGetMangaClub(t *testing.T) {
 }
 
 func TestGetMangaPicture(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaPicture(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -374,10 +374,10 @@ func TestGetMangaPicture(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaPicture(1)
 		assert.Nil(t, d)
@@ -390,7 +390,7 @@ func TestGetMangaPicture(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaPicture", 1).Return([]string{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaPicture(1)
 		assert.NotNil(t, d)
@@ -400,13 +400,13 @@ func TestGetMangaPicture(t *testing.T) {
 }
 
 func TestGetMangaMoreInfo(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMangaMoreInfo(0)
 		assert.Empty(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -416,10 +416,10 @@ func TestGetMangaMoreInfo(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaMoreInfo(1)
 		assert.Empty(t, d)
@@ -432,7 +432,7 @@ func TestGetMangaMoreInfo(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetMangaMoreInfo", 1).Return("info", http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:manga:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMangaMoreInfo(1)
 		assert.NotEmpty(t, d)
@@ -0,0 +1,295 @@
+package validator
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/model"
+)
+
+// List of negative cache failure class.
+const (
+	classNotFound    = "not-found"
+	classForbidden   = "forbidden"
+	classServerError = "server-error"
+)
+
+// All empty id keys (`internal.KeyEmpty*`) start with this.
+const emptyKeyPrefix = "mal:empty:"
+
+// Maximum number of entries in each entity's negative cache
+// index. The oldest entries are removed from the index (not
+// from the cache) when it is full.
+const maxNegativeIndexSize = 1000
+
+// Entities which can be negative cached. Each entity has
+// its own index.
+var negativeEntities = []string{
+	strings.TrimPrefix(internal.KeyEmptyAnime, emptyKeyPrefix),
+	strings.TrimPrefix(internal.KeyEmptyManga, emptyKeyPrefix),
+	strings.TrimPrefix(internal.KeyEmptyChar, emptyKeyPrefix),
+	strings.TrimPrefix(internal.KeyEmptyPeople, emptyKeyPrefix),
+	strings.TrimPrefix(internal.KeyEmptyArticle, emptyKeyPrefix),
+	strings.TrimPrefix(internal.KeyEmptyClub, emptyKeyPrefix),
+	strings.TrimPrefix(internal.KeyEmptyNews, emptyKeyPrefix),
+	strings.TrimPrefix(internal.KeyEmptyReview, emptyKeyPrefix),
+	strings.TrimPrefix(internal.KeyEmptyUser, emptyKeyPrefix),
+}
+
+// negative is negative cache policy.
+type negative struct {
+	sync.Mutex
+	ttl       model.NegativeCacheTTL
+	entityTTL map[string]model.NegativeCacheTTL
+	cacheTime time.Duration
+	index     map[string]*negativeIndex
+}
+
+// negativeData is cached data of failed request.
+// Zero `ExpiredAt` means until the main cache expired.
+type negativeData struct {
+	Code      int       `json:"code"`
+	ExpiredAt time.Time `json:"expiredAt"`
+}
+
+func (d negativeData) isExpired() bool {
+	return !d.ExpiredAt.IsZero() && !timeNow().Before(d.ExpiredAt)
+}
+
+func getNegativeClass(code int) string {
+	switch {
+	case code == http.StatusNotFound:
+		return classNotFound
+	case code == http.StatusUnauthorized, code == http.StatusForbidden:
+		return classForbidden
+	case code == http.StatusServiceUnavailable:
+		// Maintenance is not specific to the requested id.
+		return ""
+	case code >= http.StatusInternalServerError:
+		return classServerError
+	default:
+		return ""
+	}
+}
+
+// splitEmptyKey to get entity and id from empty id key.
+func splitEmptyKey(key string) (entity string, id string) {
+	key = strings.TrimPrefix(key, emptyKeyPrefix)
+	split := strings.SplitN(key, ":", 2)
+	if len(split) < 2 {
+		return split[0], ""
+	}
+	return split[0], split[1]
+}
+
+// getTTL to get negative cache expired time of the entity
+// and failure class. Returns false if it should not be cached.
+func (n *negative) getTTL(entity, class string) (time.Duration, bool) {
+	ttl := n.ttl
+	if t, ok := n.entityTTL[entity]; ok {
+		ttl = t
+	}
+
+	switch class {
+	case classNotFound:
+		return ttl.NotFound, ttl.NotFound >= 0
+	case classForbidden:
+		return ttl.Forbidden, ttl.Forbidden > 0
+	case classServerError:
+		return ttl.ServerError, ttl.ServerError > 0
+	default:
+		return 0, false
+	}
+}
+
+// negativeIndex is a list of negative cache keys saved by this
+// validator in saved order. It is kept in memory since the cacher
+// can't list keys, so entries saved by other instances sharing the
+// cache are not listed.
+type negativeIndex struct {
+	entries map[string]negativeEntry
+	order   []negativeOrder
+	seq     int
+}
+
+type negativeEntry struct {
+	data negativeData
+	seq  int
+}
+
+// negativeOrder is an entry's saved order. It is outdated
+// if the entry is deleted or saved again (different seq).
+type negativeOrder struct {
+	key string
+	seq int
+}
+
+func newNegativeIndex() *negativeIndex {
+	return &negativeIndex{entries: make(map[string]negativeEntry)}
+}
+
+func (i *negativeIndex) isCurrent(o negativeOrder) bool {
+	e, ok := i.entries[o.key]
+	return ok && e.seq == o.seq
+}
+
+// add to add or update the entry. The oldest entry is removed
+// from the index (not from the cache) when the index is full.
+func (i *negativeIndex) add(key string, d negativeData) {
+	i.seq++
+	i.entries[key] = negativeEntry{data: d, seq: i.seq}
+	i.order = append(i.order, negativeOrder{key: key, seq: i.seq})
+
+	for len(i.entries) > maxNegativeIndexSize {
+		if o := i.order[0]; i.isCurrent(o) {
+			delete(i.entries, o.key)
+		}
+		i.order = i.order[1:]
+	}
+
+	// Remove outdated order once in a while.
+	if len(i.order) > 2*maxNegativeIndexSize {
+		i.compact()
+	}
+}
+
+// keys to get the keys in saved order.
+func (i *negativeIndex) keys() []string {
+	keys := make([]string, 0, len(i.entries))
+	for _, o := range i.order {
+		if i.isCurrent(o) {
+			keys = append(keys, o.key)
+		}
+	}
+	return keys
+}
+
+func (i *negativeIndex) delete(key string) {
+	delete(i.entries, key)
+}
+
+// removeExpired to remove expired entries.
+func (i *negativeIndex) removeExpired() {
+	for k, e := range i.entries {
+		if e.data.isExpired() {
+			delete(i.entries, k)
+		}
+	}
+	i.compact()
+}
+
+// compact to remove outdated order.
+func (i *negativeIndex) compact() {
+	order := make([]negativeOrder, 0, len(i.entries))
+	for _, o := range i.order {
+		if i.isCurrent(o) {
+			order = append(order, o)
+		}
+	}
+	i.order = order
+}
+
+// getNegativeIndex to get the entity's negative cache index.
+// Should be called while holding the lock.
+func (v *Validator) getNegativeIndex(entity string) *negativeIndex {
+	index, ok := v.negative.index[entity]
+	if !ok {
+		index = newNegativeIndex()
+		v.negative.index[entity] = index
+	}
+	return index
+}
+
+func (v *Validator) addNegativeIndex(key string, d negativeData) {
+	// Entries cached until the main cache expired are
+	// pruned when the main cache expired.
+	if d.ExpiredAt.IsZero() && v.negative.cacheTime > 0 {
+		d.ExpiredAt = timeNow().Add(v.negative.cacheTime)
+	}
+
+	entity, _ := splitEmptyKey(key)
+
+	v.negative.Lock()
+	defer v.negative.Unlock()
+
+	v.getNegativeIndex(entity).add(key, d)
+}
+
+// getNegativeEntities to get entities to be listed or purged.
+func getNegativeEntities(entity string) []string {
+	if entity == "" {
+		return negativeEntities
+	}
+	return []string{entity}
+}
+
+// ListNegativeCache to get list of negative cache entries.
+// Filter by entity if `entity` is not empty.
+func (v *Validator) ListNegativeCache(entity string) []model.NegativeCache {
+	list := []model.NegativeCache{}
+	v.negative.Lock()
+	for _, e := range getNegativeEntities(entity) {
+		index, ok := v.negative.index[e]
+		if !ok {
+			continue
+		}
+		index.removeExpired()
+
+		for key, d := range index.entries {
+			_, id := splitEmptyKey(key)
+			list = append(list, model.NegativeCache{
+				Key:       key,
+				Entity:    e,
+				ID:        id,
+				Code:      d.data.Code,
+				ExpiredAt: d.data.ExpiredAt,
+			})
+		}
+	}
+	v.negative.Unlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Key < list[j].Key
+	})
+
+	return list
+}
+
+// PurgeNegativeCache to delete negative cache entries and return
+// the number of deleted entries. Delete all entries if `entity`
+// is empty.
+func (v *Validator) PurgeNegativeCache(entity string) (int, error) {
+	v.negative.Lock()
+	defer v.negative.Unlock()
+
+	var cnt int
+	for _, e := range getNegativeEntities(entity) {
+		index, ok := v.negative.index[e]
+		if !ok {
+			continue
+		}
+		index.removeExpired()
+
+		for _, key := range index.keys() {
+			if err := v.cacher.Delete(key); err != nil {
+				v.logger.Error("[%s] failed deleting cache: %s", key, err.Error())
+				index.compact()
+				return cnt, err
+			}
+
+			index.delete(key)
+			cnt++
+
+			v.logger.Debug("[%s] negative cache purged", key)
+			v.observer.Inc(internal.MetricNegativeCachePurge, map[string]string{"entity": e})
+		}
+
+		index.compact()
+	}
+
+	return cnt, nil
+}
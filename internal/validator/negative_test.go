@@ -0,0 +1,137 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetNegativeClass(t *testing.T) {
+	assert.Equal(t, classNotFound, getNegativeClass(http.StatusNotFound))
+	assert.Equal(t, classForbidden, getNegativeClass(http.StatusForbidden))
+	assert.Equal(t, classForbidden, getNegativeClass(http.StatusUnauthorized))
+	assert.Equal(t, classServerError, getNegativeClass(http.StatusBadGateway))
+	assert.Equal(t, "", getNegativeClass(http.StatusOK))
+	assert.Equal(t, "", getNegativeClass(http.StatusTooManyRequests))
+	assert.Equal(t, "", getNegativeClass(http.StatusServiceUnavailable))
+}
+
+func TestSplitEmptyKey(t *testing.T) {
+	e, id := splitEmptyKey("mal:empty:user:rl404")
+	assert.Equal(t, "user", e)
+	assert.Equal(t, "rl404", id)
+
+	e, id = splitEmptyKey("mal:empty:anime")
+	assert.Equal(t, "anime", e)
+	assert.Equal(t, "", id)
+}
+
+func TestNegativeGetTTL(t *testing.T) {
+	n := negative{
+		ttl: model.NegativeCacheTTL{ServerError: time.Minute},
+		entityTTL: map[string]model.NegativeCacheTTL{
+			"user": {NotFound: -1, Forbidden: time.Hour},
+		},
+	}
+
+	tests := []struct {
+		entity string
+		class  string
+		ttl    time.Duration
+		ok     bool
+	}{
+		{entity: "anime", class: classNotFound, ttl: 0, ok: true},
+		{entity: "anime", class: classForbidden, ttl: 0, ok: false},
+		{entity: "anime", class: classServerError, ttl: time.Minute, ok: true},
+		{entity: "user", class: classNotFound, ttl: -1, ok: false},
+		{entity: "user", class: classForbidden, ttl: time.Hour, ok: true},
+		{entity: "user", class: classServerError, ttl: 0, ok: false},
+		{entity: "user", class: "", ttl: 0, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.entity+"-"+tt.class, func(t *testing.T) {
+			ttl, ok := n.getTTL(tt.entity, tt.class)
+			assert.Equal(t, tt.ttl, ttl)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestNegativeCache(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockCacher := new(mocks.Cacher)
+	mockObserver := new(mocks.Observer)
+	mockObserver.On("Inc", "malscraper_negative_cache_purge", mock.Anything)
+
+	v := New(nil, mockCacher, mallogger.New(0, false), Config{Observer: mockObserver})
+	v.addNegativeIndex("mal:empty:user:rl404", negativeData{Code: http.StatusForbidden, ExpiredAt: now.Add(time.Hour)})
+	v.addNegativeIndex("mal:empty:user:old", negativeData{Code: http.StatusForbidden, ExpiredAt: now.Add(-time.Hour)})
+	v.addNegativeIndex("mal:empty:anime:1", negativeData{Code: http.StatusNotFound})
+	v.addNegativeIndex("mal:empty:anime:2", negativeData{Code: http.StatusInternalServerError, ExpiredAt: now.Add(time.Minute)})
+
+	t.Run("list", func(t *testing.T) {
+		assert.Equal(t, []model.NegativeCache{
+			{Key: "mal:empty:anime:1", Entity: "anime", ID: "1", Code: http.StatusNotFound},
+			{Key: "mal:empty:anime:2", Entity: "anime", ID: "2", Code: http.StatusInternalServerError, ExpiredAt: now.Add(time.Minute)},
+			{Key: "mal:empty:user:rl404", Entity: "user", ID: "rl404", Code: http.StatusForbidden, ExpiredAt: now.Add(time.Hour)},
+		}, v.ListNegativeCache(""))
+
+		assert.Len(t, v.ListNegativeCache("user"), 1)
+		assert.Len(t, v.ListNegativeCache("manga"), 0)
+	})
+
+	t.Run("purge-error", func(t *testing.T) {
+		mockCacher.On("Delete", "mal:empty:user:rl404").Return(errDummy).Once()
+		cnt, err := v.PurgeNegativeCache("user")
+		assert.Equal(t, errDummy, err)
+		assert.Zero(t, cnt)
+		assert.Len(t, v.ListNegativeCache("user"), 1)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		mockCacher.On("Delete", "mal:empty:anime:1").Return(nil).Once()
+		mockCacher.On("Delete", "mal:empty:anime:2").Return(nil).Once()
+		cnt, err := v.PurgeNegativeCache("anime")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, cnt)
+		assert.Len(t, v.ListNegativeCache("anime"), 0)
+	})
+
+	mockCacher.AssertExpectations(t)
+	mockObserver.AssertNumberOfCalls(t, "Inc", 2)
+}
+
+func TestNegativeIndex(t *testing.T) {
+	index := newNegativeIndex()
+	for i := 0; i <= maxNegativeIndexSize; i++ {
+		index.add(fmt.Sprintf("mal:empty:anime:%d", i), negativeData{})
+	}
+	assert.Len(t, index.entries, maxNegativeIndexSize)
+	assert.NotContains(t, index.entries, "mal:empty:anime:0")
+	assert.Contains(t, index.entries, "mal:empty:anime:1")
+
+	// Saved again after deleted is the newest.
+	index.delete("mal:empty:anime:1")
+	index.add("mal:empty:anime:1", negativeData{})
+	index.add("mal:empty:anime:new", negativeData{})
+	assert.Len(t, index.entries, maxNegativeIndexSize)
+	assert.NotContains(t, index.entries, "mal:empty:anime:2")
+	assert.Contains(t, index.entries, "mal:empty:anime:1")
+
+	keys := index.keys()
+	assert.Len(t, keys, maxNegativeIndexSize)
+	assert.Equal(t, "mal:empty:anime:new", keys[len(keys)-1])
+	assert.Equal(t, "mal:empty:anime:1", keys[len(keys)-2])
+	assert.LessOrEqual(t, len(index.order), 2*maxNegativeIndexSize)
+}
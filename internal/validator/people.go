@@ -16,8 +16,8 @@ func (v *Validator) GetPeople(id int) (*model.People, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyPeople, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -37,8 +37,8 @@ func (v *Validator) GetPeopleCharacter(id int) ([]model.PeopleCharacter, int, er
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyPeople, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -58,8 +58,8 @@ func (v *Validator) GetPeopleStaff(id int) ([]model.Role, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyPeople, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -79,8 +79,8 @@ func (v *Validator) GetPeopleManga(id int) ([]model.Role, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyPeople, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -100,8 +100,8 @@ func (v *Validator) GetPeopleNews(id int) ([]model.NewsItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyPeople, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -121,8 +121,8 @@ func (v *Validator) GetPeopleArticle(id int) ([]model.ArticleItem, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyPeople, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -142,8 +142,8 @@ func (v *Validator) GetPeoplePicture(id int) ([]string, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyPeople, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -13,13 +13,13 @@ import (
 )
 
 func TestGetPeople(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetPeople(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -29,10 +29,10 @@ func TestGetPeople(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeople(1)
 		assert.Nil(t, d)
@@ -45,7 +45,7 @@ func TestGetPeople(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetPeople", 1).Return(&model.People{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:people:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeople(1)
 		assert.NotNil(t, d)
@@ -55,13 +55,13 @@ func TestGetPeople(t *testing.T) {
 }
 
 func TestGetPeopleCharacter(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetPeopleCharacter(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -71,10 +71,10 @@ func TestGetPeopleCharacter(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleCharacter(1)
 		assert.Nil(t, d)
@@ -87,7 +87,7 @@ func TestGetPeopleCharacter(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetPeopleCharacter", 1).Return([]model.PeopleCharacter{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:people:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleCharacter(1)
 		assert.NotNil(t, d)
@@ -97,13 +97,13 @@ func TestGetPeopleCharacter(t *testing.T) {
 }
 
 func TestGetPeopleStaff(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetPeopleStaff(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -113,10 +113,10 @@ func TestGetPeopleStaff(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleStaff(1)
 		assert.Nil(t, d)
@@ -129,7 +129,7 @@ func TestGetPeopleStaff(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetPeopleStaff", 1).Return([]model.Role{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:people:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleStaff(1)
 		assert.NotNil(t, d)
@@ -139,13 +139,13 @@ func TestGetPeopleStaff(t *testing.T) {
 }
 
 func TestGetPeopleManga(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetPeopleManga(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -155,10 +155,10 @@ func TestGetPeopleManga(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleManga(1)
 		assert.Nil(t, d)
@@ -171,7 +171,7 @@ func TestGetPeopleManga(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetPeopleManga", 1).Return([]model.Role{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:people:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleManga(1)
 		assert.NotNil(t, d)
@@ -181,13 +181,13 @@ func TestGetPeopleManga(t *testing.T) {
 }
 
 func TestGetPeopleNews(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetPeopleNews(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -197,10 +197,10 @@ func TestGetPeopleNews(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleNews(1)
 		assert.Nil(t, d)
@@ -213,7 +213,7 @@ func TestGetPeopleNews(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetPeopleNews", 1).Return([]model.NewsItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:people:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleNews(1)
 		assert.NotNil(t, d)
@@ -223,13 +223,13 @@ func TestGetPeopleNews(t *testing.T) {
 }
 
 func TestGetPeopleArticle(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetPeopleArticle(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -239,10 +239,10 @@ func TestGetPeopleArticle(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleArticle(1)
 		assert.Nil(t, d)
@@ -255,7 +255,7 @@ func TestGetPeopleArticle(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetPeopleArticle", 1).Return([]model.ArticleItem{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:people:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeopleArticle(1)
 		assert.NotNil(t, d)
@@ -265,13 +265,13 @@ func TestGetPeopleArticle(t *testing.T) {
 }
 
 func TestGetPeoplePicture(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetPeoplePicture(0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -281,10 +281,10 @@ func TestGetPeoplePicture(t *testing.T) {
 
 	t.Run("empty-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeoplePicture(1)
 		assert.Nil(t, d)
@@ -297,7 +297,7 @@ func TestGetPeoplePicture(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:people:1", &empty).Return(errDummy).Once()
 		mockAPI.On("GetPeoplePicture", 1).Return([]string{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:people:1", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetPeoplePicture(1)
 		assert.NotNil(t, d)
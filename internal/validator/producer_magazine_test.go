@@ -18,7 +18,7 @@ func TestGetProducers(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	mockAPI.On("GetProducers").Return([]model.ItemCount{}, http.StatusOK, nil).Once()
-	v := New(mockAPI, mockCacher, mockLogger)
+	v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 	d, code, err := v.GetProducers()
 	assert.NotNil(t, d)
@@ -33,7 +33,7 @@ func TestGetProducer(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetProducer(0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -42,7 +42,7 @@ func TestGetProducer(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetProducer(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -55,7 +55,7 @@ func TestGetProducer(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ItemCount)
 			*tmp = []model.ItemCount{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetProducer(1, 1)
 		assert.Nil(t, d)
@@ -67,7 +67,7 @@ func TestGetProducer(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		mockCacher.On("Get", "mal:producers", &producers).Return(errDummy).Once()
 		mockAPI.On("GetProducer", 1, 1).Return([]model.AnimeItem{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetProducer(1, 1)
 		assert.NotNil(t, d)
@@ -82,7 +82,7 @@ func TestGetMagazines(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	mockAPI.On("GetMagazines").Return([]model.ItemCount{}, http.StatusOK, nil).Once()
-	v := New(mockAPI, mockCacher, mockLogger)
+	v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 	d, code, err := v.GetMagazines()
 	assert.NotNil(t, d)
@@ -97,7 +97,7 @@ func TestGetMagazine(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMagazine(0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -106,7 +106,7 @@ func TestGetMagazine(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetMagazine(1, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -119,7 +119,7 @@ func TestGetMagazine(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ItemCount)
 			*tmp = []model.ItemCount{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMagazine(1, 1)
 		assert.Nil(t, d)
@@ -131,7 +131,7 @@ func TestGetMagazine(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		mockCacher.On("Get", "mal:magazines", &magazines).Return(errDummy).Once()
 		mockAPI.On("GetMagazine", 1, 1).Return([]model.MangaItem{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetMagazine(1, 1)
 		assert.NotNil(t, d)
@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/utils"
+)
+
+// ValidateQuery to validate all fields of the query and return
+// all failing fields as `*errors.ValidationError`. Query should
+// be one of `model.Query`, `model.ClubQuery`, `model.UserQuery`
+// or `model.UserListQuery`. `t` (anime or manga) is only used for
+// `model.Query` and `model.UserListQuery`.
+func (v *Validator) ValidateQuery(query interface{}, t string) error {
+	switch q := query.(type) {
+	case model.Query:
+		switch t {
+		case AnimeType:
+			return v.validateAnimeQuery(q)
+		case MangaType:
+			return v.validateMangaQuery(q)
+		}
+	case model.ClubQuery:
+		return v.validateClubQuery(q)
+	case model.UserQuery:
+		return v.validateUserQuery(q)
+	case model.UserListQuery:
+		switch t {
+		case AnimeType:
+			return v.validateUserListQuery(q, animeOrders)
+		case MangaType:
+			return v.validateUserListQuery(q, mangaOrders)
+		}
+	}
+	return errors.ErrInvalidType
+}
+
+func (v *Validator) validateAnimeQuery(q model.Query) error {
+	var err errors.ValidationError
+	if len(q.Title) < 3 {
+		err.Add("Title", q.Title, errors.Err3LettersSearch)
+	}
+	if q.Page < 0 {
+		err.Add("Page", q.Page, errors.ErrInvalidPage)
+	}
+	if !utils.InArrayInt(animeTypes, q.Type) {
+		err.Add("Type", q.Type, errors.ErrInvalidType)
+	}
+	if q.Score < 0 || q.Score > 10 {
+		err.Add("Score", q.Score, errors.ErrInvalidScore)
+	}
+	if !utils.InArrayInt(animeStatuses, q.Status) {
+		err.Add("Status", q.Status, errors.ErrInvalidStatus)
+	}
+	if q.ProducerID != 0 && !v.isProducerValid(q.ProducerID) {
+		err.Add("ProducerID", q.ProducerID, errors.ErrInvalidProducer)
+	}
+	for _, g := range q.GenreIDs {
+		if !v.isAnimeGenreValid(g) {
+			err.Add("GenreIDs", g, errors.ErrInvalidGenre)
+		}
+	}
+	if !utils.InArrayInt(ratings, q.Rating) {
+		err.Add("Rating", q.Rating, errors.ErrInvalidRating)
+	}
+	if len(q.FirstLetter) > 1 {
+		err.Add("FirstLetter", q.FirstLetter, errors.ErrInvalidFirstLetter)
+	}
+	return err.OrNil()
+}
+
+func (v *Validator) validateMangaQuery(q model.Query) error {
+	var err errors.ValidationError
+	if len(q.Title) < 3 {
+		err.Add("Title", q.Title, errors.Err3LettersSearch)
+	}
+	if q.Page < 0 {
+		err.Add("Page", q.Page, errors.ErrInvalidPage)
+	}
+	if !utils.InArrayInt(mangaTypes, q.Type) {
+		err.Add("Type", q.Type, errors.ErrInvalidType)
+	}
+	if q.Score < 0 || q.Score > 10 {
+		err.Add("Score", q.Score, errors.ErrInvalidScore)
+	}
+	if !utils.InArrayInt(mangaStatuses, q.Status) {
+		err.Add("Status", q.Status, errors.ErrInvalidStatus)
+	}
+	if q.MagazineID != 0 && !v.isMagazineValid(q.MagazineID) {
+		err.Add("MagazineID", q.MagazineID, errors.ErrInvalidMagazine)
+	}
+	for _, g := range q.GenreIDs {
+		if !v.isMangaGenreValid(g) {
+			err.Add("GenreIDs", g, errors.ErrInvalidGenre)
+		}
+	}
+	if len(q.FirstLetter) > 1 {
+		err.Add("FirstLetter", q.FirstLetter, errors.ErrInvalidFirstLetter)
+	}
+	return err.OrNil()
+}
+
+func (v *Validator) validateClubQuery(q model.ClubQuery) error {
+	var err errors.ValidationError
+	if len(q.Name) < 3 {
+		err.Add("Name", q.Name, errors.Err3LettersSearch)
+	}
+	if q.Page < 0 {
+		err.Add("Page", q.Page, errors.ErrInvalidPage)
+	}
+	if !utils.InArrayInt(categories, q.Category) {
+		err.Add("Category", q.Category, errors.ErrInvalidClubCategory)
+	}
+	if !utils.InArrayInt(sorts, q.Sort) {
+		err.Add("Sort", q.Sort, errors.ErrInvalidSortType)
+	}
+	return err.OrNil()
+}
+
+func (v *Validator) validateUserQuery(q model.UserQuery) error {
+	var err errors.ValidationError
+	if len(q.Username) < 3 {
+		err.Add("Username", q.Username, errors.Err3LettersSearch)
+	}
+	if q.Page < 0 {
+		err.Add("Page", q.Page, errors.ErrInvalidPage)
+	}
+	if q.MinAge < 0 {
+		err.Add("MinAge", q.MinAge, errors.ErrInvalidAge)
+	}
+	if q.MaxAge < 0 {
+		err.Add("MaxAge", q.MaxAge, errors.ErrInvalidAge)
+	}
+	if !utils.InArrayInt(genders, q.Gender) {
+		err.Add("Gender", q.Gender, errors.ErrInvalidGender)
+	}
+	return err.OrNil()
+}
+
+func (v *Validator) validateUserListQuery(q model.UserListQuery, orders []int) error {
+	var err errors.ValidationError
+	if len(q.Username) < 2 || len(q.Username) > 16 {
+		err.Add("Username", q.Username, errors.ErrInvalidUsername)
+	}
+	if q.Page < -1 {
+		err.Add("Page", q.Page, errors.ErrInvalidPage)
+	}
+	if !utils.InArrayInt(statuses, q.Status) {
+		err.Add("Status", q.Status, errors.ErrInvalidStatus)
+	}
+	if !utils.InArrayInt(orders, q.Order) {
+		err.Add("Order", q.Order, errors.ErrInvalidOrder)
+	}
+	return err.OrNil()
+}
@@ -0,0 +1,76 @@
+package validator
+
+import (
+	e "errors"
+	"testing"
+
+	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getFields(t *testing.T, err error) []string {
+	var vErr *errors.ValidationError
+	if !assert.True(t, e.As(err, &vErr)) {
+		return nil
+	}
+	fields := make([]string, len(vErr.Fields))
+	for i, f := range vErr.Fields {
+		fields[i] = f.Field
+	}
+	return fields
+}
+
+func TestValidateQuery(t *testing.T) {
+	mockAPI := new(mocks.API)
+	mockCacher := new(mocks.Cacher)
+	mockLogger := mallogger.New(0, false)
+	v := New(mockAPI, mockCacher, mockLogger, Config{})
+
+	var genres []model.ItemCount
+	mockCacher.On("Get", "mal:genres:anime", &genres).Run(func(args mock.Arguments) {
+		*args.Get(1).(*[]model.ItemCount) = []model.ItemCount{{ID: 1}}
+	}).Return(nil)
+
+	t.Run("invalid-type", func(t *testing.T) {
+		assert.Equal(t, errors.ErrInvalidType, v.ValidateQuery(model.Query{}, "character"))
+		assert.Equal(t, errors.ErrInvalidType, v.ValidateQuery(model.UserListQuery{}, "character"))
+		assert.Equal(t, errors.ErrInvalidType, v.ValidateQuery("query", AnimeType))
+	})
+
+	t.Run("anime", func(t *testing.T) {
+		assert.NoError(t, v.ValidateQuery(model.Query{Title: "naruto", GenreIDs: []int{1}}, AnimeType))
+
+		err := v.ValidateQuery(model.Query{Title: "na", Score: 11, GenreIDs: []int{1, 2}, FirstLetter: "ab"}, AnimeType)
+		assert.Equal(t, []string{"Title", "Score", "GenreIDs", "FirstLetter"}, getFields(t, err))
+		assert.True(t, e.Is(err, errors.Err3LettersSearch))
+		assert.True(t, e.Is(err, errors.ErrInvalidScore))
+		assert.True(t, e.Is(err, errors.ErrInvalidGenre))
+		assert.True(t, e.Is(err, errors.ErrInvalidFirstLetter))
+	})
+
+	t.Run("manga", func(t *testing.T) {
+		err := v.ValidateQuery(model.Query{Title: "naruto", Page: -1, Type: 100, Status: 100}, MangaType)
+		assert.Equal(t, []string{"Page", "Type", "Status"}, getFields(t, err))
+	})
+
+	t.Run("club", func(t *testing.T) {
+		err := v.ValidateQuery(model.ClubQuery{Category: -1, Sort: -1}, AnimeType)
+		assert.Equal(t, []string{"Name", "Category", "Sort"}, getFields(t, err))
+	})
+
+	t.Run("user", func(t *testing.T) {
+		err := v.ValidateQuery(model.UserQuery{Username: "rl404", MinAge: -1, MaxAge: -1, Gender: 100}, AnimeType)
+		assert.Equal(t, []string{"MinAge", "MaxAge", "Gender"}, getFields(t, err))
+	})
+
+	t.Run("user-list", func(t *testing.T) {
+		assert.NoError(t, v.ValidateQuery(model.UserListQuery{Username: "rl404", Status: 7}, MangaType))
+
+		err := v.ValidateQuery(model.UserListQuery{Username: "a", Page: -2, Status: 100, Order: 100}, MangaType)
+		assert.Equal(t, []string{"Username", "Page", "Status", "Order"}, getFields(t, err))
+	})
+}
@@ -16,12 +16,17 @@ func (v *Validator) GetRecommendation(t string, id1, id2 int) (*model.Recommenda
 	if id1 <= 0 || id2 <= 0 {
 		return nil, http.StatusBadRequest, errors.ErrInvalidID
 	}
-	if t == AnimeType && (v.isEmptyID(internal.GetKey(internal.KeyEmptyAnime, id1)) || v.isEmptyID(internal.GetKey(internal.KeyEmptyAnime, id2))) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+
+	key := internal.KeyEmptyAnime
+	if t == MangaType {
+		key = internal.KeyEmptyManga
 	}
-	if t == MangaType && (v.isEmptyID(internal.GetKey(internal.KeyEmptyManga, id1)) || v.isEmptyID(internal.GetKey(internal.KeyEmptyManga, id2))) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	for _, id := range []int{id1, id2} {
+		if code, ok := v.isEmptyID(internal.GetKey(key, id)); ok {
+			return nil, code, errors.ErrNot200
+		}
 	}
+
 	return v.api.GetRecommendation(t, id1, id2)
 }
 
@@ -13,13 +13,13 @@ import (
 )
 
 func TestGetRecommendation(t *testing.T) {
-	var empty1, empty2 bool
+	var empty1, empty2 negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-type", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetRecommendation("", 0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -28,7 +28,7 @@ func TestGetRecommendation(t *testing.T) {
 	})
 
 	t.Run("invalid-id", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetRecommendation("anime", 0, 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -38,11 +38,11 @@ func TestGetRecommendation(t *testing.T) {
 
 	t.Run("empty-anime-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty1).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
 		mockCacher.On("Get", "mal:empty:anime:2", &empty2).Return(errDummy).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetRecommendation("anime", 1, 2)
 		assert.Nil(t, d)
@@ -53,11 +53,11 @@ func TestGetRecommendation(t *testing.T) {
 
 	t.Run("empty-manga-id", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:manga:1", &empty1).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
 		mockCacher.On("Get", "mal:empty:manga:2", &empty2).Return(errDummy).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetRecommendation("manga", 1, 2)
 		assert.Nil(t, d)
@@ -70,7 +70,7 @@ func TestGetRecommendation(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:anime:1", &empty1).Return(errDummy).Once()
 		mockCacher.On("Get", "mal:empty:anime:2", &empty2).Return(errDummy).Once()
 		mockAPI.On("GetRecommendation", "anime", 1, 2).Return(&model.Recommendation{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetRecommendation("anime", 1, 2)
 		assert.NotNil(t, d)
@@ -85,7 +85,7 @@ func TestGetRecommendations(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-type", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetRecommendations("", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -94,7 +94,7 @@ func TestGetRecommendations(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetRecommendations("anime", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -104,7 +104,7 @@ func TestGetRecommendations(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("GetRecommendations", "anime", 1).Return([]model.Recommendation{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetRecommendations("anime", 1)
 		assert.NotNil(t, d)
@@ -0,0 +1,192 @@
+package validator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rl404/go-malscraper/internal"
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Testable time now func.
+var timeNow = time.Now
+
+// Failed reference load will be retried after this duration
+// so validation won't keep requesting to MyAnimeList.
+const referenceRetryTime = time.Minute
+
+// reference keeps track of when each validator reference
+// data (genres, producers, etc) should be loaded again.
+type reference struct {
+	sync.Mutex
+	refreshTime time.Duration
+	nextLoad    map[string]time.Time
+	loading     map[string]chan struct{} // closed when loading is done
+}
+
+func newReference(refreshTime time.Duration) *reference {
+	return &reference{
+		refreshTime: refreshTime,
+		nextLoad:    make(map[string]time.Time),
+		loading:     make(map[string]chan struct{}),
+	}
+}
+
+// begin to mark the key as being loaded if it needs to be loaded
+// (or `force` is true) and nobody else is loading it. Otherwise,
+// `start` is false and `done` is the other loading's channel (if any).
+// `loaded` is true if the key has been loaded before.
+func (r *reference) begin(key string, force bool) (start, loaded bool, done chan struct{}) {
+	r.Lock()
+	defer r.Unlock()
+
+	next, loaded := r.nextLoad[key]
+	if ch, ok := r.loading[key]; ok {
+		return false, loaded, ch
+	}
+
+	if !force && loaded && timeNow().Before(next) {
+		return false, loaded, nil
+	}
+
+	r.loading[key] = make(chan struct{})
+	return true, loaded, nil
+}
+
+// end to mark the key loading is done and set its next load time.
+func (r *reference) end(key string, err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err != nil {
+		r.nextLoad[key] = timeNow().Add(referenceRetryTime)
+	} else {
+		r.nextLoad[key] = timeNow().Add(r.refreshTime)
+	}
+
+	close(r.loading[key])
+	delete(r.loading, key)
+}
+
+// referenceLoaders returns functions to get reference data
+// from the api.
+func referenceLoaders(api service.API) map[string]func() (int, error) {
+	return map[string]func() (int, error){
+		internal.GetKey(internal.KeyGenres, AnimeType): func() (int, error) {
+			_, code, err := api.GetGenres(AnimeType)
+			return code, err
+		},
+		internal.GetKey(internal.KeyGenres, MangaType): func() (int, error) {
+			_, code, err := api.GetGenres(MangaType)
+			return code, err
+		},
+		internal.KeyProducers: func() (int, error) {
+			_, code, err := api.GetProducers()
+			return code, err
+		},
+		internal.KeyMagazines: func() (int, error) {
+			_, code, err := api.GetMagazines()
+			return code, err
+		},
+		internal.KeyNewsTag: func() (int, error) {
+			_, code, err := api.GetNewsTag()
+			return code, err
+		},
+		internal.KeyArticleTag: func() (int, error) {
+			_, code, err := api.GetArticleTag()
+			return code, err
+		},
+	}
+}
+
+// loadReference to load reference data if it is not loaded
+// yet or it is time to refresh. Does nothing if reference
+// loading is disabled.
+//
+// The lock is not held while loading so other keys are not
+// blocked. If the key is being loaded by others, only the first
+// load is waited since old data can be used while refreshing.
+func (v *Validator) loadReference(key string) {
+	if v.reference == nil {
+		return
+	}
+
+	start, loaded, done := v.reference.begin(key, false)
+	if !start {
+		if done != nil && !loaded {
+			<-done
+		}
+		return
+	}
+
+	v.reference.end(key, v.loadReferenceData(key, loaded))
+}
+
+// loadReferenceData to load the reference data. If `refresh` is
+// true, the data is taken from MyAnimeList and replaces the cached
+// one only if succeeded, so the old data is kept if it fails.
+func (v *Validator) loadReferenceData(key string, refresh bool) error {
+	api := v.api
+	if refresh {
+		api = v.refreshAPI
+	}
+
+	loader, ok := referenceLoaders(api)[key]
+	if !ok {
+		return nil
+	}
+
+	v.logger.Trace("[%s] loading reference data...", key)
+	if _, err := loader(); err != nil {
+		v.logger.Error("[%s] failed loading reference data: %s", key, err.Error())
+		return err
+	}
+
+	v.logger.Debug("[%s] reference data loaded", key)
+	return nil
+}
+
+// Warmup to load all validator reference data (anime & manga
+// genres, producers, magazines, news tags, and article tags)
+// so they are ready to be used for validation. Already loaded
+// data will be refreshed.
+func (v *Validator) Warmup(ctx context.Context) error {
+	keys := []string{
+		internal.GetKey(internal.KeyGenres, AnimeType),
+		internal.GetKey(internal.KeyGenres, MangaType),
+		internal.KeyProducers,
+		internal.KeyMagazines,
+		internal.KeyNewsTag,
+		internal.KeyArticleTag,
+	}
+
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := v.warmup(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) warmup(key string) error {
+	if v.reference == nil {
+		return v.loadReferenceData(key, false)
+	}
+
+	start, loaded, done := v.reference.begin(key, true)
+	if !start {
+		// Being loaded by others.
+		<-done
+		return nil
+	}
+
+	err := v.loadReferenceData(key, loaded)
+	v.reference.end(key, err)
+	return err
+}
@@ -0,0 +1,163 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/rl404/mal-plugin/log/mallogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLoadReference(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	var producers []model.ItemCount
+	mockAPI := new(mocks.API)
+	mockCacher := new(mocks.Cacher)
+	mockLogger := mallogger.New(0, false)
+
+	t.Run("disabled", func(t *testing.T) {
+		mockCacher.On("Get", "mal:producers", &producers).Return(errDummy).Once()
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
+		assert.True(t, v.isProducerValid(2))
+		mockAPI.AssertNotCalled(t, "GetProducers")
+	})
+
+	mockRefreshAPI := new(mocks.API)
+	v := New(mockAPI, mockCacher, mockLogger, Config{ReferenceRefreshTime: time.Hour, RefreshAPI: mockRefreshAPI})
+
+	t.Run("first-load", func(t *testing.T) {
+		mockAPI.On("GetProducers").Return([]model.ItemCount{{ID: 1}}, http.StatusOK, nil).Once()
+		mockCacher.On("Get", "mal:producers", &producers).Run(func(args mock.Arguments) {
+			*args.Get(1).(*[]model.ItemCount) = []model.ItemCount{{ID: 1}}
+		}).Return(nil).Twice()
+		assert.False(t, v.isProducerValid(2))
+		assert.True(t, v.isProducerValid(1))
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		now = now.Add(2 * time.Hour)
+		mockRefreshAPI.On("GetProducers").Return([]model.ItemCount{{ID: 2}}, http.StatusOK, nil).Once()
+		mockCacher.On("Get", "mal:producers", &producers).Run(func(args mock.Arguments) {
+			*args.Get(1).(*[]model.ItemCount) = []model.ItemCount{{ID: 2}}
+		}).Return(nil).Once()
+		assert.True(t, v.isProducerValid(2))
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		now = now.Add(2 * time.Hour)
+		mockRefreshAPI.On("GetProducers").Return(nil, http.StatusInternalServerError, errDummy).Once()
+
+		// Old data is kept.
+		mockCacher.On("Get", "mal:producers", &producers).Run(func(args mock.Arguments) {
+			*args.Get(1).(*[]model.ItemCount) = []model.ItemCount{{ID: 2}}
+		}).Return(nil).Twice()
+		assert.False(t, v.isProducerValid(3))
+		assert.True(t, v.isProducerValid(2))
+		assert.Equal(t, now.Add(referenceRetryTime), v.reference.nextLoad["mal:producers"])
+	})
+
+	mockAPI.AssertExpectations(t)
+	mockRefreshAPI.AssertExpectations(t)
+	mockCacher.AssertExpectations(t)
+	mockCacher.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestLoadReferenceConcurrent(t *testing.T) {
+	var producers []model.ItemCount
+	var magazines []model.ItemCount
+	mockAPI := new(mocks.API)
+	mockCacher := new(mocks.Cacher)
+	mockLogger := mallogger.New(0, false)
+
+	block := make(chan time.Time)
+	mockAPI.On("GetProducers").WaitUntil(block).Return(nil, http.StatusOK, nil).Once()
+	mockAPI.On("GetMagazines").Return(nil, http.StatusOK, nil).Once()
+	mockCacher.On("Get", "mal:producers", &producers).Return(errDummy)
+	mockCacher.On("Get", "mal:magazines", &magazines).Return(errDummy)
+
+	v := New(mockAPI, mockCacher, mockLogger, Config{ReferenceRefreshTime: time.Hour})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Second caller waits for the first load
+			// instead of loading again.
+			assert.True(t, v.isProducerValid(1))
+		}()
+	}
+
+	// Wait until producers are being loaded.
+	for loading := false; !loading; {
+		v.reference.Lock()
+		_, loading = v.reference.loading["mal:producers"]
+		v.reference.Unlock()
+	}
+
+	// Other key is not blocked by the producer loading.
+	assert.True(t, v.isMagazineValid(1))
+
+	close(block)
+	wg.Wait()
+	mockAPI.AssertExpectations(t)
+}
+
+func TestWarmup(t *testing.T) {
+	mockAPI := new(mocks.API)
+	mockCacher := new(mocks.Cacher)
+	mockLogger := mallogger.New(0, false)
+
+	mockAPI.On("GetGenres", AnimeType).Return(nil, http.StatusOK, nil)
+	mockAPI.On("GetGenres", MangaType).Return(nil, http.StatusOK, nil)
+	mockAPI.On("GetProducers").Return(nil, http.StatusOK, nil)
+	mockAPI.On("GetMagazines").Return(nil, http.StatusOK, nil)
+	mockAPI.On("GetNewsTag").Return(nil, http.StatusOK, nil)
+	mockAPI.On("GetArticleTag").Return(nil, http.StatusOK, nil)
+
+	t.Run("disabled", func(t *testing.T) {
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
+		assert.NoError(t, v.Warmup(context.Background()))
+		assert.Nil(t, v.reference)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		mockRefreshAPI := new(mocks.API)
+		v := New(mockAPI, mockCacher, mockLogger, Config{ReferenceRefreshTime: time.Hour, RefreshAPI: mockRefreshAPI})
+		assert.NoError(t, v.Warmup(context.Background()))
+		assert.Len(t, v.reference.nextLoad, 6)
+
+		// Second warmup refreshes.
+		mockRefreshAPI.On("GetGenres", AnimeType).Return(nil, http.StatusOK, nil).Once()
+		mockRefreshAPI.On("GetGenres", MangaType).Return(nil, http.StatusOK, nil).Once()
+		mockRefreshAPI.On("GetProducers").Return(nil, http.StatusOK, nil).Once()
+		mockRefreshAPI.On("GetMagazines").Return(nil, http.StatusOK, nil).Once()
+		mockRefreshAPI.On("GetNewsTag").Return(nil, http.StatusOK, nil).Once()
+		mockRefreshAPI.On("GetArticleTag").Return(nil, http.StatusOK, nil).Once()
+		assert.NoError(t, v.Warmup(context.Background()))
+		mockRefreshAPI.AssertExpectations(t)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		v := New(mockAPI, mockCacher, mockLogger, Config{ReferenceRefreshTime: time.Hour})
+		assert.Equal(t, context.Canceled, v.Warmup(ctx))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockAPI := new(mocks.API)
+		mockAPI.On("GetGenres", AnimeType).Return(nil, http.StatusInternalServerError, errDummy)
+		v := New(mockAPI, mockCacher, mockLogger, Config{ReferenceRefreshTime: time.Hour})
+		assert.Equal(t, errDummy, v.Warmup(context.Background()))
+	})
+}
@@ -16,8 +16,8 @@ func (v *Validator) GetReview(id int) (*model.Review, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyReview, id)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
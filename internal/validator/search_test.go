@@ -19,7 +19,7 @@ func TestSearchAnime(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-title", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchAnime(model.Query{Title: ""})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -28,7 +28,7 @@ func TestSearchAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto", Page: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -37,7 +37,7 @@ func TestSearchAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-type", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto", Type: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -46,7 +46,7 @@ func TestSearchAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-score", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto", Score: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -55,7 +55,7 @@ func TestSearchAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-status", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto", Status: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -68,7 +68,7 @@ func TestSearchAnime(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ItemCount)
 			*tmp = []model.ItemCount{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto", ProducerID: -1})
 		assert.Nil(t, d)
@@ -82,7 +82,7 @@ func TestSearchAnime(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ItemCount)
 			*tmp = []model.ItemCount{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto", GenreIDs: []int{0}})
 		assert.Nil(t, d)
@@ -92,7 +92,7 @@ func TestSearchAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-rating", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto", Rating: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -101,7 +101,7 @@ func TestSearchAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-firstletter", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto", FirstLetter: "ab"})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -111,7 +111,7 @@ func TestSearchAnime(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("SearchAnime", model.Query{Title: "naruto", Page: 1}).Return([]model.AnimeSearch{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchAnime(model.Query{Title: "naruto"})
 		assert.NotNil(t, d)
@@ -127,7 +127,7 @@ func TestSearchManga(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-title", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchManga(model.Query{Title: ""})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -136,7 +136,7 @@ func TestSearchManga(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchManga(model.Query{Title: "naruto", Page: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -145,7 +145,7 @@ func TestSearchManga(t *testing.T) {
 	})
 
 	t.Run("invalid-type", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchManga(model.Query{Title: "naruto", Type: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -154,7 +154,7 @@ func TestSearchManga(t *testing.T) {
 	})
 
 	t.Run("invalid-score", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchManga(model.Query{Title: "naruto", Score: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -163,7 +163,7 @@ func TestSearchManga(t *testing.T) {
 	})
 
 	t.Run("invalid-status", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchManga(model.Query{Title: "naruto", Status: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -176,7 +176,7 @@ func TestSearchManga(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ItemCount)
 			*tmp = []model.ItemCount{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchManga(model.Query{Title: "naruto", MagazineID: -1})
 		assert.Nil(t, d)
@@ -190,7 +190,7 @@ func TestSearchManga(t *testing.T) {
 			tmp := args.Get(1).(*[]model.ItemCount)
 			*tmp = []model.ItemCount{}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchManga(model.Query{Title: "naruto", GenreIDs: []int{0}})
 		assert.Nil(t, d)
@@ -200,7 +200,7 @@ func TestSearchManga(t *testing.T) {
 	})
 
 	t.Run("invalid-firstletter", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchManga(model.Query{Title: "naruto", FirstLetter: "ab"})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -210,7 +210,7 @@ func TestSearchManga(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("SearchManga", model.Query{Title: "naruto", Page: 1}).Return([]model.MangaSearch{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchManga(model.Query{Title: "naruto"})
 		assert.NotNil(t, d)
@@ -225,7 +225,7 @@ func TestSearchCharacter(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-name", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchCharacter("", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -234,7 +234,7 @@ func TestSearchCharacter(t *testing.T) {
 	})
 
 	t.Run("invalid-name", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchCharacter("naruto", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -244,7 +244,7 @@ func TestSearchCharacter(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("SearchCharacter", "naruto", 1).Return([]model.CharacterSearch{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchCharacter("naruto", 1)
 		assert.NotNil(t, d)
@@ -259,7 +259,7 @@ func TestSearchPeople(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-name", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchPeople("", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -268,7 +268,7 @@ func TestSearchPeople(t *testing.T) {
 	})
 
 	t.Run("invalid-name", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchPeople("naruto", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -278,7 +278,7 @@ func TestSearchPeople(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("SearchPeople", "naruto", 1).Return([]model.PeopleSearch{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchPeople("naruto", 1)
 		assert.NotNil(t, d)
@@ -293,7 +293,7 @@ func TestSearchClub(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-name", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchClub(model.ClubQuery{Name: ""})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -302,7 +302,7 @@ func TestSearchClub(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchClub(model.ClubQuery{Name: "naruto", Page: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -311,7 +311,7 @@ func TestSearchClub(t *testing.T) {
 	})
 
 	t.Run("invalid-category", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchClub(model.ClubQuery{Name: "naruto", Category: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -320,7 +320,7 @@ func TestSearchClub(t *testing.T) {
 	})
 
 	t.Run("invalid-sort", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchClub(model.ClubQuery{Name: "naruto", Sort: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -330,7 +330,7 @@ func TestSearchClub(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("SearchClub", model.ClubQuery{Name: "naruto", Page: 1}).Return([]model.ClubSearch{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchClub(model.ClubQuery{Name: "naruto"})
 		assert.NotNil(t, d)
@@ -345,7 +345,7 @@ func TestSearchUser(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-name", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchUser(model.UserQuery{Username: ""})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -354,7 +354,7 @@ func TestSearchUser(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchUser(model.UserQuery{Username: "rl404", Page: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -363,7 +363,7 @@ func TestSearchUser(t *testing.T) {
 	})
 
 	t.Run("invalid-age", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchUser(model.UserQuery{Username: "rl404", MinAge: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -372,7 +372,7 @@ func TestSearchUser(t *testing.T) {
 	})
 
 	t.Run("invalid-gender", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.SearchUser(model.UserQuery{Username: "rl404", Gender: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -382,7 +382,7 @@ func TestSearchUser(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("SearchUser", model.UserQuery{Username: "rl404", Page: 1}).Return([]model.UserSearch{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.SearchUser(model.UserQuery{Username: "rl404"})
 		assert.NotNil(t, d)
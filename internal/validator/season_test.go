@@ -17,7 +17,7 @@ func TestGetSeason(t *testing.T) {
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-season", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetSeason("", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -26,7 +26,7 @@ func TestGetSeason(t *testing.T) {
 	})
 
 	t.Run("invalid-year", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetSeason("winter", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -36,7 +36,7 @@ func TestGetSeason(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 		mockAPI.On("GetSeason", "winter", 2019).Return([]model.AnimeItem{}, http.StatusOK, nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetSeason("winter", 2019)
 		assert.NotNil(t, d)
@@ -6,7 +6,6 @@ import (
 	"github.com/rl404/go-malscraper/errors"
 	"github.com/rl404/go-malscraper/internal"
 	"github.com/rl404/go-malscraper/model"
-	"github.com/rl404/go-malscraper/pkg/utils"
 )
 
 // GetUser to get user detail information.
@@ -17,8 +16,8 @@ func (v *Validator) GetUser(username string) (*model.User, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -38,8 +37,8 @@ func (v *Validator) GetUserStats(username string) (*model.UserStats, int, error)
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -59,8 +58,8 @@ func (v *Validator) GetUserFavorite(username string) (*model.UserFavorite, int,
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -83,8 +82,8 @@ func (v *Validator) GetUserFriend(username string, page int) ([]model.UserFriend
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -107,8 +106,8 @@ func (v *Validator) GetUserHistory(username string, t string) ([]model.UserHisto
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -131,8 +130,8 @@ func (v *Validator) GetUserReview(username string, page int) ([]model.Review, in
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -155,8 +154,8 @@ func (v *Validator) GetUserRecommendation(username string, page int) ([]model.Re
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -176,8 +175,8 @@ func (v *Validator) GetUserClub(username string) ([]model.Item, int, error) {
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -191,26 +190,17 @@ func (v *Validator) GetUserClub(username string) ([]model.Item, int, error) {
 
 // GetUserAnime to get user anime list.
 func (v *Validator) GetUserAnime(query model.UserListQuery) ([]model.UserAnime, int, error) {
-	if len(query.Username) < 2 || len(query.Username) > 16 {
-		return nil, http.StatusBadRequest, errors.ErrInvalidUsername
+	if err := v.validateUserListQuery(query, animeOrders); err != nil {
+		return nil, http.StatusBadRequest, err
 	}
 	if query.Page == 0 {
 		query.Page = 1
 	}
-	if query.Page < -1 {
-		return nil, http.StatusBadRequest, errors.ErrInvalidPage
-	}
-	if !utils.InArrayInt(statuses, query.Status) {
-		return nil, http.StatusBadRequest, errors.ErrInvalidStatus
-	}
-	if !utils.InArrayInt(animeOrders, query.Order) {
-		return nil, http.StatusBadRequest, errors.ErrInvalidOrder
-	}
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, query.Username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
@@ -224,26 +214,17 @@ func (v *Validator) GetUserAnime(query model.UserListQuery) ([]model.UserAnime,
 
 // GetUserManga to get user manga list.
 func (v *Validator) GetUserManga(query model.UserListQuery) ([]model.UserManga, int, error) {
-	if len(query.Username) < 2 || len(query.Username) > 16 {
-		return nil, http.StatusBadRequest, errors.ErrInvalidUsername
+	if err := v.validateUserListQuery(query, mangaOrders); err != nil {
+		return nil, http.StatusBadRequest, err
 	}
 	if query.Page == 0 {
 		query.Page = 1
 	}
-	if query.Page < -1 {
-		return nil, http.StatusBadRequest, errors.ErrInvalidPage
-	}
-	if !utils.InArrayInt(statuses, query.Status) {
-		return nil, http.StatusBadRequest, errors.ErrInvalidStatus
-	}
-	if !utils.InArrayInt(mangaOrders, query.Order) {
-		return nil, http.StatusBadRequest, errors.ErrInvalidOrder
-	}
 
 	// Check empty id.
 	key := internal.GetKey(internal.KeyEmptyUser, query.Username)
-	if v.isEmptyID(key) {
-		return nil, http.StatusNotFound, errors.ErrNot200
+	if code, ok := v.isEmptyID(key); ok {
+		return nil, code, errors.ErrNot200
 	}
 
 	// Parse.
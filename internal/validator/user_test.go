@@ -13,13 +13,13 @@ import (
 )
 
 func TestGetUser(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUser("")
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -29,10 +29,10 @@ func TestGetUser(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUser("rl404")
 		assert.Nil(t, d)
@@ -45,7 +45,7 @@ func TestGetUser(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUser", "rl404").Return(&model.User{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUser("rl404")
 		assert.NotNil(t, d)
@@ -55,13 +55,13 @@ func TestGetUser(t *testing.T) {
 }
 
 func TestGetUserStats(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserStats("")
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -71,10 +71,10 @@ func TestGetUserStats(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserStats("rl404")
 		assert.Nil(t, d)
@@ -87,7 +87,7 @@ func TestGetUserStats(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserStats", "rl404").Return(&model.UserStats{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserStats("rl404")
 		assert.NotNil(t, d)
@@ -97,13 +97,13 @@ func TestGetUserStats(t *testing.T) {
 }
 
 func TestGetUserFavorite(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserFavorite("")
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -113,10 +113,10 @@ func TestGetUserFavorite(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserFavorite("rl404")
 		assert.Nil(t, d)
@@ -129,7 +129,7 @@ func TestGetUserFavorite(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserFavorite", "rl404").Return(&model.UserFavorite{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserFavorite("rl404")
 		assert.NotNil(t, d)
@@ -139,13 +139,13 @@ func TestGetUserFavorite(t *testing.T) {
 }
 
 func TestGetUserFriend(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserFriend("", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -154,7 +154,7 @@ func TestGetUserFriend(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserFriend("rl404", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -164,10 +164,10 @@ func TestGetUserFriend(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserFriend("rl404", 1)
 		assert.Nil(t, d)
@@ -180,7 +180,7 @@ func TestGetUserFriend(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserFriend", "rl404", 1).Return([]model.UserFriend{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserFriend("rl404", 1)
 		assert.NotNil(t, d)
@@ -190,13 +190,13 @@ func TestGetUserFriend(t *testing.T) {
 }
 
 func TestGetUserHistory(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserHistory("", "")
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -205,7 +205,7 @@ func TestGetUserHistory(t *testing.T) {
 	})
 
 	t.Run("invalid-type", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserHistory("rl404", "t")
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -215,10 +215,10 @@ func TestGetUserHistory(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserHistory("rl404", "")
 		assert.Nil(t, d)
@@ -231,7 +231,7 @@ func TestGetUserHistory(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserHistory", "rl404", "").Return([]model.UserHistory{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserHistory("rl404", "")
 		assert.NotNil(t, d)
@@ -241,13 +241,13 @@ func TestGetUserHistory(t *testing.T) {
 }
 
 func TestGetUserReview(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserReview("", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -256,7 +256,7 @@ func TestGetUserReview(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserReview("rl404", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -266,10 +266,10 @@ func TestGetUserReview(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserReview("rl404", 1)
 		assert.Nil(t, d)
@@ -282,7 +282,7 @@ func TestGetUserReview(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserReview", "rl404", 1).Return([]model.Review{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserReview("rl404", 1)
 		assert.NotNil(t, d)
@@ -292,13 +292,13 @@ func TestGetUserReview(t *testing.T) {
 }
 
 func TestGetUserRecommendation(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserRecommendation("", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -307,7 +307,7 @@ func TestGetUserRecommendation(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserRecommendation("rl404", 0)
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -317,10 +317,10 @@ func TestGetUserRecommendation(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserRecommendation("rl404", 1)
 		assert.Nil(t, d)
@@ -333,7 +333,7 @@ func TestGetUserRecommendation(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserRecommendation", "rl404", 1).Return([]model.Recommendation{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserRecommendation("rl404", 1)
 		assert.NotNil(t, d)
@@ -343,13 +343,13 @@ func TestGetUserRecommendation(t *testing.T) {
 }
 
 func TestGetUserClub(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserClub("")
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -359,10 +359,10 @@ func TestGetUserClub(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserClub("rl404")
 		assert.Nil(t, d)
@@ -375,7 +375,7 @@ func TestGetUserClub(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserClub", "rl404").Return([]model.Item{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserClub("rl404")
 		assert.NotNil(t, d)
@@ -385,13 +385,13 @@ func TestGetUserClub(t *testing.T) {
 }
 
 func TestGetUserAnime(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserAnime(model.UserListQuery{Username: ""})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -400,7 +400,7 @@ func TestGetUserAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserAnime(model.UserListQuery{Username: "rl404", Page: -2})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -409,7 +409,7 @@ func TestGetUserAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-status", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserAnime(model.UserListQuery{Username: "rl404", Status: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -418,7 +418,7 @@ func TestGetUserAnime(t *testing.T) {
 	})
 
 	t.Run("invalid-order", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserAnime(model.UserListQuery{Username: "rl404", Order: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -428,10 +428,10 @@ func TestGetUserAnime(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserAnime(model.UserListQuery{Username: "rl404"})
 		assert.Nil(t, d)
@@ -444,7 +444,7 @@ func TestGetUserAnime(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserAnime", model.UserListQuery{Username: "rl404", Page: 1}).Return([]model.UserAnime{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserAnime(model.UserListQuery{Username: "rl404"})
 		assert.NotNil(t, d)
@@ -454,13 +454,13 @@ func TestGetUserAnime(t *testing.T) {
 }
 
 func TestGetUserManga(t *testing.T) {
-	var empty bool
+	var empty negativeData
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := mallogger.New(0, false)
 
 	t.Run("invalid-user", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserManga(model.UserListQuery{Username: ""})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -469,7 +469,7 @@ func TestGetUserManga(t *testing.T) {
 	})
 
 	t.Run("invalid-page", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserManga(model.UserListQuery{Username: "rl404", Page: -2})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -478,7 +478,7 @@ func TestGetUserManga(t *testing.T) {
 	})
 
 	t.Run("invalid-status", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserManga(model.UserListQuery{Username: "rl404", Status: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -487,7 +487,7 @@ func TestGetUserManga(t *testing.T) {
 	})
 
 	t.Run("invalid-order", func(t *testing.T) {
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 		d, code, err := v.GetUserManga(model.UserListQuery{Username: "rl404", Order: -1})
 		assert.Nil(t, d)
 		assert.Equal(t, http.StatusBadRequest, code)
@@ -497,10 +497,10 @@ func TestGetUserManga(t *testing.T) {
 
 	t.Run("empty-user", func(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusNotFound}
 		}).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserManga(model.UserListQuery{Username: "rl404"})
 		assert.Nil(t, d)
@@ -513,7 +513,7 @@ func TestGetUserManga(t *testing.T) {
 		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Return(errDummy).Once()
 		mockAPI.On("GetUserManga", model.UserListQuery{Username: "rl404", Page: 1}).Return([]model.UserManga{}, http.StatusOK, nil).Once()
 		mockCacher.On("Set", "mal:empty:user:rl404", true).Return(nil).Once()
-		v := New(mockAPI, mockCacher, mockLogger)
+		v := New(mockAPI, mockCacher, mockLogger, Config{})
 
 		d, code, err := v.GetUserManga(model.UserListQuery{Username: "rl404"})
 		assert.NotNil(t, d)
@@ -1,7 +1,7 @@
 package validator
 
 import (
-	"net/http"
+	"time"
 
 	"github.com/rl404/go-malscraper/internal"
 	"github.com/rl404/go-malscraper/model"
@@ -11,38 +11,111 @@ import (
 // Validator will intercept and validate request data
 // before processing the request.
 type Validator struct {
-	api    service.API
-	cacher service.Cacher
-	logger service.Logger
+	api        service.API
+	refreshAPI service.API
+	cacher     service.Cacher
+	logger     service.Logger
+	observer   service.Observer
+	reference  *reference
+	negative   *negative
+}
+
+// Config is validator config.
+type Config struct {
+	// If more than 0, reference data (genres, producers, etc)
+	// will be loaded when first needed and refreshed every
+	// `ReferenceRefreshTime`. Otherwise, only the cached ones
+	// will be used.
+	ReferenceRefreshTime time.Duration
+	// API which always gets data from MyAnimeList and replaces
+	// the cached one. Used to refresh reference data so the old
+	// data is kept if refreshing fails. Default is the main API.
+	RefreshAPI service.API
+	// Main cache expired time. Negative cache entries which are
+	// cached until the main cache expired are removed from the
+	// negative cache index after this duration.
+	CacheTime time.Duration
+	// Negative cache expired time for each failure class.
+	NegativeCache model.NegativeCacheTTL
+	// Negative cache expired time for specific entity. Key is
+	// the entity name in `internal.KeyEmpty*` (anime, user, etc).
+	NegativeCacheEntity map[string]model.NegativeCacheTTL
+	// Metrics observer.
+	Observer service.Observer
 }
 
 // New to create new validator.
-func New(api service.API, c service.Cacher, l service.Logger) service.API {
-	return &Validator{
-		api:    api,
-		cacher: c,
-		logger: l,
+func New(api service.API, c service.Cacher, l service.Logger, cfg Config) *Validator {
+	v := &Validator{
+		api:        api,
+		refreshAPI: cfg.RefreshAPI,
+		cacher:     c,
+		logger:     l,
+		observer:   cfg.Observer,
+		negative: &negative{
+			ttl:       cfg.NegativeCache,
+			entityTTL: cfg.NegativeCacheEntity,
+			cacheTime: cfg.CacheTime,
+			index:     make(map[string]*negativeIndex),
+		},
+	}
+	if v.refreshAPI == nil {
+		v.refreshAPI = api
+	}
+	if v.observer == nil {
+		v.observer = internal.NopObserver{}
+	}
+	if cfg.ReferenceRefreshTime > 0 {
+		v.reference = newReference(cfg.ReferenceRefreshTime)
 	}
+	return v
 }
 
-func (v *Validator) isEmptyID(key string) (empty bool) {
+// isEmptyID to check if the id is cached as failed request
+// and return the cached response code.
+func (v *Validator) isEmptyID(key string) (int, bool) {
 	v.logger.Trace("[%s] checking empty id...", key)
-	if v.cacher.Get(key, &empty) == nil {
-		v.logger.Debug("[%s] found empty id", key)
-		return empty
+
+	var d negativeData
+	if v.cacher.Get(key, &d) != nil || d.isExpired() {
+		return 0, false
 	}
-	return false
+
+	entity, _ := splitEmptyKey(key)
+	class := getNegativeClass(d.Code)
+	v.logger.Debug("[%s] found empty id (%d %s)", key, d.Code, class)
+	v.observer.Inc(internal.MetricNegativeCacheHit, map[string]string{"entity": entity, "class": class})
+	return d.Code, true
 }
 
+// saveEmptyID to cache failed request according to negative
+// cache policy.
 func (v *Validator) saveEmptyID(code int, key string) {
-	if code != http.StatusNotFound {
+	class := getNegativeClass(code)
+	if class == "" {
+		return
+	}
+
+	entity, _ := splitEmptyKey(key)
+	ttl, ok := v.negative.getTTL(entity, class)
+	if !ok {
 		return
 	}
 
+	d := negativeData{Code: code}
+	if ttl > 0 {
+		d.ExpiredAt = timeNow().Add(ttl)
+	}
+
 	v.logger.Trace("[%s] saving empty id...", key)
-	if err := v.cacher.Set(key, true); err != nil {
+	if err := v.cacher.Set(key, d); err != nil {
 		v.logger.Error("[%s] failed saving cache: %s", key, err.Error())
+		return
 	}
+
+	v.logger.Debug("[%s] empty id saved (%d %s, ttl %s)", key, code, class, ttl)
+	v.observer.Inc(internal.MetricNegativeCacheSave, map[string]string{"entity": entity, "class": class})
+	v.addNegativeIndex(key, d)
 }
 
 func (v *Validator) isArticleTagValid(tag string) bool {
@@ -50,6 +123,8 @@ func (v *Validator) isArticleTagValid(tag string) bool {
 		return true
 	}
 
+	v.loadReference(internal.KeyArticleTag)
+
 	var tags []model.ArticleTagItem
 	v.logger.Trace("[%s] checking valid article tag...", internal.KeyArticleTag)
 	if v.cacher.Get(internal.KeyArticleTag, &tags) == nil {
@@ -68,6 +143,8 @@ func (v *Validator) isAnimeGenreValid(id int) bool {
 		return false
 	}
 
+	v.loadReference(internal.GetKey(internal.KeyGenres, AnimeType))
+
 	var genres []model.ItemCount
 	v.logger.Trace("[%s] checking valid anime genre...", internal.GetKey(internal.KeyGenres, AnimeType))
 	if v.cacher.Get(internal.GetKey(internal.KeyGenres, AnimeType), &genres) == nil {
@@ -86,6 +163,8 @@ func (v *Validator) isMangaGenreValid(id int) bool {
 		return false
 	}
 
+	v.loadReference(internal.GetKey(internal.KeyGenres, MangaType))
+
 	var genres []model.ItemCount
 	v.logger.Trace("[%s] checking valid manga genre...", internal.GetKey(internal.KeyGenres, MangaType))
 	if v.cacher.Get(internal.GetKey(internal.KeyGenres, MangaType), &genres) == nil {
@@ -104,6 +183,8 @@ func (v *Validator) isNewsTagValid(tag string) bool {
 		return true
 	}
 
+	v.loadReference(internal.KeyNewsTag)
+
 	var tags model.NewsTag
 	v.logger.Trace("[%s] checking valid news tag...", internal.KeyNewsTag)
 	if v.cacher.Get(internal.KeyNewsTag, &tags) == nil {
@@ -147,6 +228,8 @@ func (v *Validator) isProducerValid(id int) bool {
 		return false
 	}
 
+	v.loadReference(internal.KeyProducers)
+
 	var producers []model.ItemCount
 	v.logger.Trace("[%s] checking valid producer...", internal.KeyProducers)
 	if v.cacher.Get(internal.KeyProducers, &producers) == nil {
@@ -165,6 +248,8 @@ func (v *Validator) isMagazineValid(id int) bool {
 		return false
 	}
 
+	v.loadReference(internal.KeyMagazines)
+
 	var magazines []model.ItemCount
 	v.logger.Trace("[%s] checking valid magazine...", internal.KeyMagazines)
 	if v.cacher.Get(internal.KeyMagazines, &magazines) == nil {
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/rl404/go-malscraper/model"
 	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/rl404/mal-plugin/log/mallogger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -17,63 +19,117 @@ func TestNew(t *testing.T) {
 	mockAPI := new(mocks.API)
 	mockCacher := new(mocks.Cacher)
 	mockLogger := new(mocks.Logger)
-	_ = New(mockAPI, mockCacher, mockLogger)
+	_ = New(mockAPI, mockCacher, mockLogger, Config{})
 }
 
 func TestIsEmptyID(t *testing.T) {
-	var empty bool
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	var empty negativeData
 	mockCacher := new(mocks.Cacher)
 	mockLogger := new(mocks.Logger)
+	mockObserver := new(mocks.Observer)
 
 	t.Run("empty", func(t *testing.T) {
+		mockLogger.On("Trace", "[%s] checking empty id...", "mal:empty:user:rl404").Once()
+		mockCacher.On("Get", "mal:empty:user:rl404", &empty).Run(func(args mock.Arguments) {
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusForbidden, ExpiredAt: now.Add(time.Minute)}
+		}).Return(nil).Once()
+		mockLogger.On("Debug", "[%s] found empty id (%d %s)", "mal:empty:user:rl404", http.StatusForbidden, classForbidden).Once()
+		mockObserver.On("Inc", "malscraper_negative_cache_hit", map[string]string{"entity": "user", "class": classForbidden}).Once()
+		v := &Validator{cacher: mockCacher, logger: mockLogger, observer: mockObserver}
+
+		code, e := v.isEmptyID("mal:empty:user:rl404")
+		assert.True(t, e)
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("expired", func(t *testing.T) {
 		mockLogger.On("Trace", "[%s] checking empty id...", "key").Once()
 		mockCacher.On("Get", "key", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
+			tmp := args.Get(1).(*negativeData)
+			*tmp = negativeData{Code: http.StatusForbidden, ExpiredAt: now}
 		}).Return(nil).Once()
-		mockLogger.On("Debug", "[%s] found empty id", "key").Once()
-		v := &Validator{cacher: mockCacher, logger: mockLogger}
+		v := &Validator{cacher: mockCacher, logger: mockLogger, observer: mockObserver}
 
-		e := v.isEmptyID("key")
-		assert.True(t, e)
+		_, e := v.isEmptyID("key")
+		assert.False(t, e)
 	})
 
 	t.Run("not-empty", func(t *testing.T) {
 		mockLogger.On("Trace", "[%s] checking empty id...", "key").Once()
-		mockCacher.On("Get", "key", &empty).Run(func(args mock.Arguments) {
-			tmp := args.Get(1).(*bool)
-			*tmp = true
-		}).Return(errDummy).Once()
-		v := &Validator{cacher: mockCacher, logger: mockLogger}
+		mockCacher.On("Get", "key", &empty).Return(errDummy).Once()
+		v := &Validator{cacher: mockCacher, logger: mockLogger, observer: mockObserver}
 
-		e := v.isEmptyID("key")
+		_, e := v.isEmptyID("key")
 		assert.False(t, e)
 	})
 
+	mockObserver.AssertExpectations(t)
 }
 
 func TestSaveEmptyID(t *testing.T) {
-	mockCacher := new(mocks.Cacher)
-	mockLogger := new(mocks.Logger)
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	key := "mal:empty:user:rl404"
+	mockLogger := mallogger.New(0, false)
+
+	newValidator := func(c *mocks.Cacher, o *mocks.Observer) *Validator {
+		return New(nil, c, mockLogger, Config{
+			NegativeCache: model.NegativeCacheTTL{ServerError: time.Minute},
+			NegativeCacheEntity: map[string]model.NegativeCacheTTL{
+				"user": {NotFound: time.Hour, Forbidden: 2 * time.Hour},
+			},
+			Observer: o,
+		})
+	}
 
 	t.Run("ok", func(t *testing.T) {
-		v := &Validator{cacher: mockCacher, logger: mockLogger}
-		v.saveEmptyID(http.StatusOK, "key")
+		v := newValidator(new(mocks.Cacher), new(mocks.Observer))
+		v.saveEmptyID(http.StatusOK, key)
+		v.saveEmptyID(http.StatusBadRequest, key)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		v := newValidator(new(mocks.Cacher), new(mocks.Observer))
+		v.saveEmptyID(http.StatusForbidden, "mal:empty:anime:1")
+		v.saveEmptyID(http.StatusInternalServerError, key)
 	})
 
 	t.Run("error", func(t *testing.T) {
-		mockLogger.On("Trace", "[%s] saving empty id...", "key")
-		mockCacher.On("Set", "key", true).Return(errDummy).Once()
-		mockLogger.On("Error", "[%s] failed saving cache: %s", "key", errDummy.Error())
-		v := &Validator{cacher: mockCacher, logger: mockLogger}
-		v.saveEmptyID(http.StatusNotFound, "key")
+		mockCacher := new(mocks.Cacher)
+		mockCacher.On("Set", key, negativeData{Code: http.StatusNotFound, ExpiredAt: now.Add(time.Hour)}).Return(errDummy).Once()
+		v := newValidator(mockCacher, new(mocks.Observer))
+		v.saveEmptyID(http.StatusNotFound, key)
+		mockCacher.AssertExpectations(t)
 	})
 
-	t.Run("no-error", func(t *testing.T) {
-		mockLogger.On("Trace", "[%s] saving empty id...", "key")
-		mockCacher.On("Set", "key", true).Return(nil).Once()
-		v := &Validator{cacher: mockCacher, logger: mockLogger}
-		v.saveEmptyID(http.StatusNotFound, "key")
+	t.Run("saved", func(t *testing.T) {
+		mockCacher := new(mocks.Cacher)
+		mockObserver := new(mocks.Observer)
+		mockCacher.On("Set", key, negativeData{Code: http.StatusForbidden, ExpiredAt: now.Add(2 * time.Hour)}).Return(nil).Once()
+		mockObserver.On("Inc", "malscraper_negative_cache_save", map[string]string{"entity": "user", "class": classForbidden}).Once()
+		v := newValidator(mockCacher, mockObserver)
+		v.saveEmptyID(http.StatusForbidden, key)
+		mockCacher.AssertExpectations(t)
+		mockObserver.AssertExpectations(t)
+		assert.Equal(t, negativeData{Code: http.StatusForbidden, ExpiredAt: now.Add(2 * time.Hour)}, v.negative.index["user"].entries[key].data)
+	})
+
+	t.Run("default-not-found", func(t *testing.T) {
+		mockCacher := new(mocks.Cacher)
+		mockCacher.On("Set", "mal:empty:anime:1", negativeData{Code: http.StatusNotFound}).Return(nil).Once()
+		v := New(nil, mockCacher, mockLogger, Config{CacheTime: 24 * time.Hour})
+		v.saveEmptyID(http.StatusNotFound, "mal:empty:anime:1")
+		mockCacher.AssertExpectations(t)
+
+		// Indexed until the main cache expired.
+		assert.Equal(t, negativeData{Code: http.StatusNotFound, ExpiredAt: now.Add(24 * time.Hour)}, v.negative.index["anime"].entries["mal:empty:anime:1"].data)
 	})
 }
 
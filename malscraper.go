@@ -1,11 +1,15 @@
 package malscraper
 
 import (
+	"context"
 	"time"
 
+	"github.com/rl404/go-malscraper/internal"
 	"github.com/rl404/go-malscraper/internal/cacher"
+	"github.com/rl404/go-malscraper/internal/graphql"
 	"github.com/rl404/go-malscraper/internal/parser"
 	"github.com/rl404/go-malscraper/internal/validator"
+	"github.com/rl404/go-malscraper/model"
 	"github.com/rl404/go-malscraper/service"
 	"github.com/rl404/mal-plugin/cache/nocache"
 )
@@ -13,8 +17,16 @@ import (
 // Malscraper is malscraper instance which contains all
 // methods to parse MyAnimeList web page.
 type Malscraper struct {
-	api    service.API
-	cacher service.Cacher
+	api       service.API
+	validator *validator.Validator
+	parser    *parser.Parser
+	reparser  service.API
+	planner   *internal.Planner
+	cacher    service.Cacher
+	logger    service.Logger
+
+	graphqlCfg graphql.Config
+	legacyEnum bool
 }
 
 // New to create new malscraper with config.
@@ -24,21 +36,87 @@ func New(cfg Config) (*Malscraper, error) {
 		return nil, err
 	}
 
+	// Stamp cached data with version so data cached by
+	// older model or parser will not be used.
+	c := cacher.NewVersion(cfg.Cacher)
+	useStale := cfg.StaleCacheTime > 0 && cfg.CacheTime > 0
+
 	// Init the core of malscraper which access and parse
 	// MyAnimeList web.
-	api := parser.New(cfg.CleanImageURL, cfg.CleanVideoURL, cfg.Logger)
+	pCfg := parser.Config{
+		BreakerThreshold: cfg.CircuitBreakerThreshold,
+		BreakerTimeout:   cfg.CircuitBreakerTimeout,
+		Observer:         cfg.Observer,
+		HTTPClient:       cfg.HTTPClient,
+		Headers:          cfg.Headers,
+		UserAgent:        cfg.UserAgent,
+		RequestHook:      cfg.RequestHook,
+		PageCacher:       cfg.PageCacher,
+		PageCacheTime:    cfg.PageCacheTime,
+	}
+	if cfg.ConditionalRequest && useStale {
+		pCfg.Cacher = c
+	}
+	if cfg.DryRun {
+		pCfg.Planner = internal.NewPlanner()
+	}
+	p := parser.New(cfg.CleanImageURL, cfg.CleanVideoURL, cfg.Logger, pCfg)
+	var api service.API = p
+
+	// Keep expired data a bit longer to be used while
+	// MyAnimeList is unavailable or to be refreshed
+	// with conditional request.
+	if useStale {
+		c = cacher.NewStale(c, cfg.CacheTime, p.IsCircuitOpen, cfg.Logger, cfg.Observer)
+	}
 
 	// Init cacher which intercepts request to check to
 	// cache first before actually access and parse MyAnimeList.
-	api = cacher.New(api, cfg.Cacher, cfg.Logger)
+	// Also record cache usage in dry-run mode.
+	ac := c
+	if cfg.DryRun {
+		ac = cacher.NewPlan(c, pCfg.Planner)
+	}
+	api = cacher.New(api, ac, cfg.Logger, cfg.SearchCacheTime)
+
+	// Init another cacher which parses saved raw pages
+	// and replaces the cached data.
+	var reparser service.API
+	if cfg.PageCacher != nil {
+		reparser = cacher.New(p.Offline(), cacher.NewRefresh(c), cfg.Logger, cfg.SearchCacheTime)
+	}
 
 	// Init validator which validates requested params
 	// before processing the request.
-	api = validator.New(api, cfg.Cacher, cfg.Logger)
+	vCfg := validator.Config{
+		CacheTime:           cfg.CacheTime,
+		NegativeCache:       cfg.NegativeCache,
+		NegativeCacheEntity: cfg.NegativeCacheEntity,
+		Observer:            cfg.Observer,
+	}
+	if useStale {
+		vCfg.CacheTime += cfg.StaleCacheTime
+	}
+	if cfg.LoadReference {
+		vCfg.ReferenceRefreshTime = cfg.ReferenceRefreshTime
+		vCfg.RefreshAPI = cacher.New(p, cacher.NewRefresh(c), cfg.Logger, cfg.SearchCacheTime)
+	}
+	v := validator.New(api, c, cfg.Logger, vCfg)
 
 	return &Malscraper{
-		api:    api,
-		cacher: cfg.Cacher,
+		api:       v,
+		validator: v,
+		parser:    p,
+		reparser:  reparser,
+		planner:   pCfg.Planner,
+		cacher:    c,
+		logger:    cfg.Logger,
+
+		graphqlCfg: graphql.Config{
+			MaxDepth: cfg.GraphQLMaxDepth,
+			MaxCost:  cfg.GraphQLMaxCost,
+		},
+		legacyEnum: cfg.LegacyEnumJSON,
 	}, nil
 }
 
@@ -73,3 +151,22 @@ func (m *Malscraper) Close() error {
 	}
 	return m.cacher.Close()
 }
+
+// JSONValue to get data to be encoded to JSON. If `LegacyEnumJSON`
+// is true, anime & manga enums will be encoded as their original
+// string. Otherwise, data is returned as it is.
+func (m *Malscraper) JSONValue(data interface{}) interface{} {
+	if !m.legacyEnum {
+		return data
+	}
+	return model.LegacyEnum(data)
+}
+
+// Warmup to load and cache validator reference data (anime & manga
+// genres, producers, magazines, news tags, and article tags) so
+// invalid genre, producer, etc can be rejected without accessing
+// MyAnimeList. Recommended to be called at startup if
+// `LoadReference` config is enabled.
+func (m *Malscraper) Warmup(ctx context.Context) error {
+	return m.validator.Warmup(ctx)
+}
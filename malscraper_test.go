@@ -1,13 +1,21 @@
 package malscraper
 
 import (
+	"context"
+	"encoding/json"
 	e "errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/rl404/go-malscraper/errors"
+	"github.com/rl404/go-malscraper/model"
 	"github.com/rl404/mal-plugin/cache/bigcache"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var errDummy = e.New("dummy error")
@@ -62,3 +70,47 @@ func TestClose(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestJSONValue(t *testing.T) {
+	data := &model.Anime{Type: model.AnimeTypeTV}
+
+	legacy, _ := New(Config{LegacyEnumJSON: true})
+	b, err := json.Marshal(legacy.JSONValue(data))
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"type":"TV"`)
+
+	// Other instance is not affected.
+	m, _ := NewNoCache()
+	b, err = json.Marshal(m.JSONValue(data))
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"type":1`)
+}
+
+type mockHTTPClient struct {
+	sync.Mutex
+	urls []string
+}
+
+func (c *mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.urls = append(c.urls, req.URL.String())
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`<html><div id="contentwrapper"></div></html>`)),
+	}, nil
+}
+
+func TestWarmup(t *testing.T) {
+	client := &mockHTTPClient{}
+	m, err := New(Config{LoadReference: true, CacheTime: time.Hour, HTTPClient: client})
+	require.NoError(t, err)
+
+	assert.NoError(t, m.Warmup(context.Background()))
+	assert.Len(t, client.urls, 6)
+
+	// Second warmup refreshes.
+	assert.NoError(t, m.Warmup(context.Background()))
+	assert.Len(t, client.urls, 12)
+}
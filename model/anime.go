@@ -16,15 +16,16 @@ type Anime struct {
 	Popularity        int              `json:"popularity"`
 	Member            int              `json:"member"`
 	Favorite          int              `json:"favorite"`
-	Type              string           `json:"type"`
+	Type              AnimeType        `json:"type"`
 	Episode           int              `json:"episode"`
-	Status            string           `json:"status"`
+	Status            AnimeStatus      `json:"status"`
 	AiringDate        StartEndDate     `json:"airingDate"`
 	Premiered         string           `json:"premiered"`
 	Broadcast         string           `json:"broadcast"`
-	Source            string           `json:"source"`
+	BroadcastTime     Broadcast        `json:"broadcastTime"`
+	Source            AnimeSource      `json:"source"`
 	Duration          string           `json:"duration"`
-	Rating            string           `json:"rating"`
+	Rating            AnimeRating      `json:"rating"`
 	Producers         []Item           `json:"producers"`
 	Licensors         []Item           `json:"licensors"`
 	Studios           []Item           `json:"studios"`
@@ -33,6 +34,15 @@ type Anime struct {
 	Song              Song             `json:"song"`
 }
 
+// Broadcast represents parsed anime weekly broadcast time.
+type Broadcast struct {
+	Day      time.Weekday `json:"day"`
+	Hour     int          `json:"hour"`
+	Minute   int          `json:"minute"`
+	Timezone string       `json:"timezone"`
+	Unknown  bool         `json:"unknown"`
+}
+
 // Song represents list of opening and ending anime songs.
 type Song struct {
 	Opening []string `json:"opening"`
@@ -70,31 +80,32 @@ type VideoPromo struct {
 
 // AnimeItem represents simpler anime model for producer/seasonal anime.
 type AnimeItem struct {
-	ID        int      `json:"id"`
-	Title     string   `json:"title"`
-	Image     string   `json:"image"`
-	Source    string   `json:"source"`
-	Episode   int      `json:"episode"`
-	Type      string   `json:"type"`
-	Member    int      `json:"member"`
-	Score     float64  `json:"score"`
-	StartDate Date     `json:"startDate"`
-	Synopsis  string   `json:"synopsis"`
-	Genres    []Item   `json:"genres"`
-	Producers []Item   `json:"producers"`
-	Licensors []string `json:"licensors"`
+	ID            int         `json:"id"`
+	Title         string      `json:"title"`
+	Image         string      `json:"image"`
+	Source        AnimeSource `json:"source"`
+	Episode       int         `json:"episode"`
+	Type          AnimeType   `json:"type"`
+	Member        int         `json:"member"`
+	Score         float64     `json:"score"`
+	StartDate     Date        `json:"startDate"`
+	BroadcastTime Broadcast   `json:"broadcastTime"`
+	Synopsis      string      `json:"synopsis"`
+	Genres        []Item      `json:"genres"`
+	Producers     []Item      `json:"producers"`
+	Licensors     []string    `json:"licensors"`
 }
 
 // TopAnime represents model for top anime list.
 type TopAnime struct {
-	Rank      int     `json:"rank"`
-	Title     string  `json:"title"`
-	ID        int     `json:"id"`
-	Image     string  `json:"image"`
-	Type      string  `json:"type"`
-	Episode   int     `json:"episode"`
-	StartDate Date    `json:"startDate"`
-	EndDate   Date    `json:"endDate"`
-	Member    int     `json:"member"`
-	Score     float64 `json:"score"`
+	Rank      int       `json:"rank"`
+	Title     string    `json:"title"`
+	ID        int       `json:"id"`
+	Image     string    `json:"image"`
+	Type      AnimeType `json:"type"`
+	Episode   int       `json:"episode"`
+	StartDate Date      `json:"startDate"`
+	EndDate   Date      `json:"endDate"`
+	Member    int       `json:"member"`
+	Score     float64   `json:"score"`
 }
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// NegativeCacheTTL represents negative cache expired time for
+// each failure class.
+//
+// NotFound is for 404 response. Zero means cached until the main
+// cache expired.
+//
+// Forbidden is for 401 & 403 response (private list, etc).
+// ServerError is for 5xx response. Zero means not cached.
+//
+// Negative value means not cached.
+type NegativeCacheTTL struct {
+	NotFound    time.Duration
+	Forbidden   time.Duration
+	ServerError time.Duration
+}
+
+// NegativeCache represents negative cache entry.
+type NegativeCache struct {
+	Key       string    `json:"key"`
+	Entity    string    `json:"entity"`
+	ID        string    `json:"id"`
+	Code      int       `json:"code"`
+	ExpiredAt time.Time `json:"expiredAt"`
+}
@@ -0,0 +1,453 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// enum contains enum value and its name. Aliases are other
+// names that will be accepted when parsing.
+//
+// Names which are not known by the enum (e.g. new type "TV Special")
+// are kept as negative value so the original string is not lost.
+// They are always encoded as their original string in JSON. Only
+// the first `maxRawNames` names are kept, the others are unknown.
+type enum struct {
+	names   map[int]string
+	aliases map[string]int
+}
+
+// Limit of names which are not known by any enum. The names may
+// come from untrusted input (e.g. user's XML list) so they are
+// limited to prevent unbounded memory usage.
+const (
+	maxRawNames      = 100
+	maxRawNameLength = 50
+)
+
+// rawNames contains names which are not known by any enum.
+// Value -1 is the first name, -2 is the second, and so on.
+var rawNames = struct {
+	sync.RWMutex
+	names  []string
+	values map[string]int
+}{values: make(map[string]int)}
+
+func getRawValue(name string) int {
+	rawNames.RLock()
+	v, ok := rawNames.values[name]
+	rawNames.RUnlock()
+	if ok {
+		return v
+	}
+
+	if len(name) > maxRawNameLength {
+		return 0
+	}
+
+	rawNames.Lock()
+	defer rawNames.Unlock()
+	if v, ok := rawNames.values[name]; ok {
+		return v
+	}
+	if len(rawNames.names) >= maxRawNames {
+		return 0
+	}
+	rawNames.names = append(rawNames.names, name)
+	v = -len(rawNames.names)
+	rawNames.values[name] = v
+	return v
+}
+
+func getRawName(v int) (string, bool) {
+	rawNames.RLock()
+	defer rawNames.RUnlock()
+	if i := -v - 1; i >= 0 && i < len(rawNames.names) {
+		return rawNames.names[i], true
+	}
+	return "", false
+}
+
+func (e enum) string(v int) string {
+	if name, ok := e.names[v]; ok {
+		return name
+	}
+	if name, ok := getRawName(v); ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// legacy to get the name used in legacy JSON encoding.
+func (e enum) legacy(v int) string {
+	if v == 0 {
+		return ""
+	}
+	return e.string(v)
+}
+
+func (e enum) parse(str string) int {
+	str = strings.TrimSpace(str)
+	if str == "" || strings.EqualFold(str, "unknown") {
+		return 0
+	}
+
+	if v, ok := e.lookup(strings.ToLower(str)); ok {
+		return v
+	}
+
+	return getRawValue(str)
+}
+
+func (e enum) lookup(str string) (int, bool) {
+	for k, v := range e.names {
+		if strings.ToLower(v) == str {
+			return k, true
+		}
+	}
+
+	if v, ok := e.aliases[str]; ok {
+		return v, true
+	}
+
+	// For string like "PG-13 - Teens 13 or older".
+	if i := strings.Index(str, " - "); i > 0 {
+		return e.lookup(str[:i])
+	}
+
+	return 0, false
+}
+
+func (e enum) marshal(v int) ([]byte, error) {
+	if name, ok := getRawName(v); ok {
+		return json.Marshal(name)
+	}
+	return json.Marshal(v)
+}
+
+func (e enum) unmarshal(b []byte) (int, error) {
+	var v int
+	if err := json.Unmarshal(b, &v); err == nil {
+		if v < 0 {
+			// Unknown value only has meaning in the process
+			// which encoded it.
+			return 0, nil
+		}
+		return v, nil
+	}
+
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return 0, err
+	}
+
+	return e.parse(str), nil
+}
+
+// AnimeType is anime type (TV, movie, etc).
+type AnimeType int
+
+// Anime types. Same value as in query param.
+const (
+	AnimeTypeTV AnimeType = iota + 1
+	AnimeTypeOVA
+	AnimeTypeMovie
+	AnimeTypeSpecial
+	AnimeTypeONA
+	AnimeTypeMusic
+)
+
+var animeTypes = enum{
+	names: map[int]string{
+		int(AnimeTypeTV):      "TV",
+		int(AnimeTypeOVA):     "OVA",
+		int(AnimeTypeMovie):   "Movie",
+		int(AnimeTypeSpecial): "Special",
+		int(AnimeTypeONA):     "ONA",
+		int(AnimeTypeMusic):   "Music",
+	},
+}
+
+// ParseAnimeType to parse anime type string.
+func ParseAnimeType(str string) AnimeType {
+	return AnimeType(animeTypes.parse(str))
+}
+
+// String to get anime type name.
+func (t AnimeType) String() string {
+	return animeTypes.string(int(t))
+}
+
+// MarshalJSON to encode anime type to JSON.
+func (t AnimeType) MarshalJSON() ([]byte, error) {
+	return animeTypes.marshal(int(t))
+}
+
+// UnmarshalJSON to decode anime type from JSON number or string.
+func (t *AnimeType) UnmarshalJSON(b []byte) error {
+	v, err := animeTypes.unmarshal(b)
+	*t = AnimeType(v)
+	return err
+}
+
+// AnimeStatus is anime airing status.
+type AnimeStatus int
+
+// Anime airing status. Same value as in query param.
+const (
+	AnimeStatusAiring AnimeStatus = iota + 1
+	AnimeStatusFinished
+	AnimeStatusUpcoming
+)
+
+var animeStatuses = enum{
+	names: map[int]string{
+		int(AnimeStatusAiring):   "Currently Airing",
+		int(AnimeStatusFinished): "Finished Airing",
+		int(AnimeStatusUpcoming): "Not yet aired",
+	},
+}
+
+// ParseAnimeStatus to parse anime airing status string.
+func ParseAnimeStatus(str string) AnimeStatus {
+	return AnimeStatus(animeStatuses.parse(str))
+}
+
+// String to get anime airing status name.
+func (s AnimeStatus) String() string {
+	return animeStatuses.string(int(s))
+}
+
+// MarshalJSON to encode anime airing status to JSON.
+func (s AnimeStatus) MarshalJSON() ([]byte, error) {
+	return animeStatuses.marshal(int(s))
+}
+
+// UnmarshalJSON to decode anime airing status from JSON number or string.
+func (s *AnimeStatus) UnmarshalJSON(b []byte) error {
+	v, err := animeStatuses.unmarshal(b)
+	*s = AnimeStatus(v)
+	return err
+}
+
+// AnimeRating is anime age rating.
+type AnimeRating int
+
+// Anime ratings. Same value as in query param.
+const (
+	AnimeRatingG AnimeRating = iota + 1
+	AnimeRatingPG
+	AnimeRatingPG13
+	AnimeRatingR17
+	AnimeRatingR
+	AnimeRatingRx
+)
+
+var animeRatings = enum{
+	names: map[int]string{
+		int(AnimeRatingG):    "G - All Ages",
+		int(AnimeRatingPG):   "PG - Children",
+		int(AnimeRatingPG13): "PG-13 - Teens 13 or older",
+		int(AnimeRatingR17):  "R - 17+ (violence & profanity)",
+		int(AnimeRatingR):    "R+ - Mild Nudity",
+		int(AnimeRatingRx):   "Rx - Hentai",
+	},
+	aliases: map[string]int{
+		"g":     int(AnimeRatingG),
+		"pg":    int(AnimeRatingPG),
+		"pg-13": int(AnimeRatingPG13),
+		"r":     int(AnimeRatingR17),
+		"r+":    int(AnimeRatingR),
+		"rx":    int(AnimeRatingRx),
+	},
+}
+
+// ParseAnimeRating to parse anime rating string. Both long
+// ("PG-13 - Teens 13 or older") and short ("PG-13") form are accepted.
+func ParseAnimeRating(str string) AnimeRating {
+	return AnimeRating(animeRatings.parse(str))
+}
+
+// String to get anime rating name.
+func (r AnimeRating) String() string {
+	return animeRatings.string(int(r))
+}
+
+// MarshalJSON to encode anime rating to JSON.
+func (r AnimeRating) MarshalJSON() ([]byte, error) {
+	return animeRatings.marshal(int(r))
+}
+
+// UnmarshalJSON to decode anime rating from JSON number or string.
+func (r *AnimeRating) UnmarshalJSON(b []byte) error {
+	v, err := animeRatings.unmarshal(b)
+	*r = AnimeRating(v)
+	return err
+}
+
+// AnimeSource is anime source material.
+type AnimeSource int
+
+// Anime sources.
+const (
+	AnimeSourceOriginal AnimeSource = iota + 1
+	AnimeSourceManga
+	AnimeSource4KomaManga
+	AnimeSourceWebManga
+	AnimeSourceDigitalManga
+	AnimeSourceNovel
+	AnimeSourceLightNovel
+	AnimeSourceVisualNovel
+	AnimeSourceGame
+	AnimeSourceCardGame
+	AnimeSourceBook
+	AnimeSourcePictureBook
+	AnimeSourceRadio
+	AnimeSourceMusic
+	AnimeSourceOther
+	AnimeSourceWebNovel
+	AnimeSourceMixedMedia
+)
+
+var animeSources = enum{
+	names: map[int]string{
+		int(AnimeSourceOriginal):     "Original",
+		int(AnimeSourceManga):        "Manga",
+		int(AnimeSource4KomaManga):   "4-koma manga",
+		int(AnimeSourceWebManga):     "Web manga",
+		int(AnimeSourceDigitalManga): "Digital manga",
+		int(AnimeSourceNovel):        "Novel",
+		int(AnimeSourceLightNovel):   "Light novel",
+		int(AnimeSourceVisualNovel):  "Visual novel",
+		int(AnimeSourceGame):         "Game",
+		int(AnimeSourceCardGame):     "Card game",
+		int(AnimeSourceBook):         "Book",
+		int(AnimeSourcePictureBook):  "Picture book",
+		int(AnimeSourceRadio):        "Radio",
+		int(AnimeSourceMusic):        "Music",
+		int(AnimeSourceOther):        "Other",
+		int(AnimeSourceWebNovel):     "Web novel",
+		int(AnimeSourceMixedMedia):   "Mixed media",
+	},
+}
+
+// ParseAnimeSource to parse anime source string.
+func ParseAnimeSource(str string) AnimeSource {
+	return AnimeSource(animeSources.parse(str))
+}
+
+// String to get anime source name.
+func (s AnimeSource) String() string {
+	return animeSources.string(int(s))
+}
+
+// MarshalJSON to encode anime source to JSON.
+func (s AnimeSource) MarshalJSON() ([]byte, error) {
+	return animeSources.marshal(int(s))
+}
+
+// UnmarshalJSON to decode anime source from JSON number or string.
+func (s *AnimeSource) UnmarshalJSON(b []byte) error {
+	v, err := animeSources.unmarshal(b)
+	*s = AnimeSource(v)
+	return err
+}
+
+// MangaType is manga type (manga, light novel, etc).
+type MangaType int
+
+// Manga types. Same value as in query param.
+const (
+	MangaTypeManga MangaType = iota + 1
+	MangaTypeLightNovel
+	MangaTypeOneShot
+	MangaTypeDoujinshi
+	MangaTypeManhwa
+	MangaTypeManhua
+	_
+	MangaTypeNovel
+)
+
+var mangaTypes = enum{
+	names: map[int]string{
+		int(MangaTypeManga):      "Manga",
+		int(MangaTypeLightNovel): "Light Novel",
+		int(MangaTypeOneShot):    "One-shot",
+		int(MangaTypeDoujinshi):  "Doujinshi",
+		int(MangaTypeManhwa):     "Manhwa",
+		int(MangaTypeManhua):     "Manhua",
+		int(MangaTypeNovel):      "Novel",
+	},
+	aliases: map[string]int{
+		"one shot": int(MangaTypeOneShot),
+		"oneshot":  int(MangaTypeOneShot),
+		"doujin":   int(MangaTypeDoujinshi),
+	},
+}
+
+// ParseMangaType to parse manga type string.
+func ParseMangaType(str string) MangaType {
+	return MangaType(mangaTypes.parse(str))
+}
+
+// String to get manga type name.
+func (t MangaType) String() string {
+	return mangaTypes.string(int(t))
+}
+
+// MarshalJSON to encode manga type to JSON.
+func (t MangaType) MarshalJSON() ([]byte, error) {
+	return mangaTypes.marshal(int(t))
+}
+
+// UnmarshalJSON to decode manga type from JSON number or string.
+func (t *MangaType) UnmarshalJSON(b []byte) error {
+	v, err := mangaTypes.unmarshal(b)
+	*t = MangaType(v)
+	return err
+}
+
+// MangaStatus is manga publishing status.
+type MangaStatus int
+
+// Manga publishing status. Same value as in query param.
+const (
+	MangaStatusPublishing MangaStatus = iota + 1
+	MangaStatusFinished
+	MangaStatusUpcoming
+	MangaStatusHiatus
+	MangaStatusDiscontinued
+)
+
+var mangaStatuses = enum{
+	names: map[int]string{
+		int(MangaStatusPublishing):   "Publishing",
+		int(MangaStatusFinished):     "Finished",
+		int(MangaStatusUpcoming):     "Not yet published",
+		int(MangaStatusHiatus):       "On Hiatus",
+		int(MangaStatusDiscontinued): "Discontinued",
+	},
+}
+
+// ParseMangaStatus to parse manga publishing status string.
+func ParseMangaStatus(str string) MangaStatus {
+	return MangaStatus(mangaStatuses.parse(str))
+}
+
+// String to get manga publishing status name.
+func (s MangaStatus) String() string {
+	return mangaStatuses.string(int(s))
+}
+
+// MarshalJSON to encode manga publishing status to JSON.
+func (s MangaStatus) MarshalJSON() ([]byte, error) {
+	return mangaStatuses.marshal(int(s))
+}
+
+// UnmarshalJSON to decode manga publishing status from JSON number or string.
+func (s *MangaStatus) UnmarshalJSON(b []byte) error {
+	v, err := mangaStatuses.unmarshal(b)
+	*s = MangaStatus(v)
+	return err
+}
@@ -0,0 +1,156 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	assert.Equal(t, AnimeType(0), ParseAnimeType(""))
+	assert.Equal(t, AnimeType(0), ParseAnimeType("Unknown"))
+	assert.Equal(t, AnimeTypeTV, ParseAnimeType(" tv "))
+	assert.Equal(t, AnimeTypeONA, ParseAnimeType("ONA"))
+	assert.Equal(t, AnimeStatusAiring, ParseAnimeStatus("Currently Airing"))
+	assert.Equal(t, AnimeStatusUpcoming, ParseAnimeStatus("Not yet aired"))
+	assert.Equal(t, AnimeRatingPG13, ParseAnimeRating("PG-13 - Teens 13 or older"))
+	assert.Equal(t, AnimeRatingPG13, ParseAnimeRating("PG-13"))
+	assert.Equal(t, AnimeRatingR17, ParseAnimeRating("R"))
+	assert.Equal(t, AnimeRatingR, ParseAnimeRating("R+"))
+	assert.Equal(t, AnimeRatingRx, ParseAnimeRating("Rx - Hentai"))
+	assert.Equal(t, "None", ParseAnimeRating("None").String())
+	assert.Equal(t, AnimeSource4KomaManga, ParseAnimeSource("4-koma manga"))
+	assert.Equal(t, AnimeSourceLightNovel, ParseAnimeSource("Light novel"))
+	assert.Equal(t, MangaTypeOneShot, ParseMangaType("One-shot"))
+	assert.Equal(t, MangaTypeNovel, ParseMangaType("Novel"))
+	assert.Equal(t, MangaStatusHiatus, ParseMangaStatus("On Hiatus"))
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "Unknown", AnimeType(0).String())
+	assert.Equal(t, "Movie", AnimeTypeMovie.String())
+	assert.Equal(t, "Finished Airing", AnimeStatusFinished.String())
+	assert.Equal(t, "R - 17+ (violence & profanity)", AnimeRatingR17.String())
+	assert.Equal(t, "Web novel", AnimeSourceWebNovel.String())
+	assert.Equal(t, "Light Novel", MangaTypeLightNovel.String())
+	assert.Equal(t, "Discontinued", MangaStatusDiscontinued.String())
+}
+
+func TestJSON(t *testing.T) {
+	a := Anime{
+		Type:   AnimeTypeTV,
+		Status: AnimeStatusFinished,
+		Source: AnimeSourceOriginal,
+		Rating: AnimeRatingR17,
+	}
+
+	t.Run("default", func(t *testing.T) {
+		b, err := json.Marshal(a)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"type":1`)
+		assert.Contains(t, string(b), `"status":2`)
+		assert.Contains(t, string(b), `"source":1`)
+		assert.Contains(t, string(b), `"rating":4`)
+
+		var d Anime
+		require.NoError(t, json.Unmarshal(b, &d))
+		assert.Equal(t, a, d)
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		m := Manga{ID: 1, Type: MangaTypeManga}
+		b, err := json.Marshal(LegacyEnum(&m))
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `{"id":1,`)
+		assert.Contains(t, string(b), `"type":"Manga"`)
+		assert.Contains(t, string(b), `"status":""`)
+
+		var d Manga
+		require.NoError(t, json.Unmarshal(b, &d))
+		assert.Equal(t, m, d)
+
+		// Default encoding is not affected.
+		b, err = json.Marshal(m)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"type":1`)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		a := Anime{
+			Type:   ParseAnimeType("TV Special"),
+			Rating: ParseAnimeRating("None"),
+		}
+		assert.Equal(t, "TV Special", a.Type.String())
+
+		for _, data := range []interface{}{a, LegacyEnum([]Anime{a})} {
+			b, err := json.Marshal(data)
+			require.NoError(t, err)
+			assert.Contains(t, string(b), `"type":"TV Special"`)
+			assert.Contains(t, string(b), `"rating":"None"`)
+		}
+
+		b, err := json.Marshal(a)
+		require.NoError(t, err)
+
+		var d Anime
+		require.NoError(t, json.Unmarshal(b, &d))
+		assert.Equal(t, a, d)
+	})
+
+	t.Run("old-cache", func(t *testing.T) {
+		var d Anime
+		require.NoError(t, json.Unmarshal([]byte(`{"type":"TV","status":"Currently Airing","source":"Manga","rating":"PG-13 - Teens 13 or older"}`), &d))
+		assert.Equal(t, AnimeTypeTV, d.Type)
+		assert.Equal(t, AnimeStatusAiring, d.Status)
+		assert.Equal(t, AnimeSourceManga, d.Source)
+		assert.Equal(t, AnimeRatingPG13, d.Rating)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var d Anime
+		assert.Error(t, json.Unmarshal([]byte(`{"type":true}`), &d))
+	})
+}
+
+func TestRawNameLimit(t *testing.T) {
+	rawNames.Lock()
+	names, values := rawNames.names, rawNames.values
+	rawNames.names, rawNames.values = nil, make(map[string]int)
+	rawNames.Unlock()
+	defer func() {
+		rawNames.Lock()
+		rawNames.names, rawNames.values = names, values
+		rawNames.Unlock()
+	}()
+
+	for i := 0; i < maxRawNames; i++ {
+		assert.Equal(t, AnimeType(-i-1), ParseAnimeType(fmt.Sprintf("Type %d", i)))
+	}
+	assert.Equal(t, AnimeType(-1), ParseAnimeType("Type 0"))
+	assert.Equal(t, AnimeType(0), ParseAnimeType("One more"))
+	assert.Equal(t, "Unknown", ParseAnimeType("One more").String())
+
+	rawNames.names, rawNames.values = nil, make(map[string]int)
+	assert.Equal(t, AnimeType(0), ParseAnimeType(strings.Repeat("a", maxRawNameLength+1)))
+}
+
+func TestLegacyEnum(t *testing.T) {
+	// Without enum.
+	item := Item{ID: 1, Name: "Action"}
+	assert.Equal(t, item, LegacyEnum(item))
+	assert.Nil(t, LegacyEnum(nil))
+
+	data := map[string][]*UserAnime{
+		"list": {{ID: 1, Type: AnimeTypeMovie, Rating: AnimeRatingG}, nil},
+	}
+	b, err := json.Marshal(LegacyEnum(data))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `{"list":[{"id":1,`)
+	assert.Contains(t, string(b), `"type":"Movie"`)
+	assert.Contains(t, string(b), `"rating":"G - All Ages"`)
+	assert.Contains(t, string(b), `,null]}`)
+}
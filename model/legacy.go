@@ -0,0 +1,183 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+)
+
+// LegacyEnum to convert data so enums are encoded as their original
+// MyAnimeList string (e.g. "Currently Airing") just like before the
+// enums were introduced. Other fields are encoded the same as the
+// original data.
+//
+// Enums are encoded as integer by default. Use this only when encoding
+// data for clients which still expect the old string encoding. Enums
+// inside interface fields are not converted.
+func LegacyEnum(data interface{}) interface{} {
+	t, ok := getLegacyType(reflect.TypeOf(data))
+	if !ok {
+		return data
+	}
+
+	// Copy the data to the same type but with legacy enums.
+	b, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+
+	v := reflect.New(t)
+	if err := json.Unmarshal(b, v.Interface()); err != nil {
+		return data
+	}
+
+	return v.Elem().Interface()
+}
+
+// Legacy enums are the same as the enums but encoded as their
+// name. Unknown value is encoded as empty string.
+type (
+	legacyAnimeType   AnimeType
+	legacyAnimeStatus AnimeStatus
+	legacyAnimeRating AnimeRating
+	legacyAnimeSource AnimeSource
+	legacyMangaType   MangaType
+	legacyMangaStatus MangaStatus
+)
+
+var legacyEnumTypes = map[reflect.Type]reflect.Type{
+	reflect.TypeOf(AnimeType(0)):   reflect.TypeOf(legacyAnimeType(0)),
+	reflect.TypeOf(AnimeStatus(0)): reflect.TypeOf(legacyAnimeStatus(0)),
+	reflect.TypeOf(AnimeRating(0)): reflect.TypeOf(legacyAnimeRating(0)),
+	reflect.TypeOf(AnimeSource(0)): reflect.TypeOf(legacyAnimeSource(0)),
+	reflect.TypeOf(MangaType(0)):   reflect.TypeOf(legacyMangaType(0)),
+	reflect.TypeOf(MangaStatus(0)): reflect.TypeOf(legacyMangaStatus(0)),
+}
+
+func (t legacyAnimeType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(animeTypes.legacy(int(t)))
+}
+
+func (t *legacyAnimeType) UnmarshalJSON(b []byte) error {
+	return (*AnimeType)(t).UnmarshalJSON(b)
+}
+
+func (s legacyAnimeStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(animeStatuses.legacy(int(s)))
+}
+
+func (s *legacyAnimeStatus) UnmarshalJSON(b []byte) error {
+	return (*AnimeStatus)(s).UnmarshalJSON(b)
+}
+
+func (r legacyAnimeRating) MarshalJSON() ([]byte, error) {
+	return json.Marshal(animeRatings.legacy(int(r)))
+}
+
+func (r *legacyAnimeRating) UnmarshalJSON(b []byte) error {
+	return (*AnimeRating)(r).UnmarshalJSON(b)
+}
+
+func (s legacyAnimeSource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(animeSources.legacy(int(s)))
+}
+
+func (s *legacyAnimeSource) UnmarshalJSON(b []byte) error {
+	return (*AnimeSource)(s).UnmarshalJSON(b)
+}
+
+func (t legacyMangaType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(mangaTypes.legacy(int(t)))
+}
+
+func (t *legacyMangaType) UnmarshalJSON(b []byte) error {
+	return (*MangaType)(t).UnmarshalJSON(b)
+}
+
+func (s legacyMangaStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(mangaStatuses.legacy(int(s)))
+}
+
+func (s *legacyMangaStatus) UnmarshalJSON(b []byte) error {
+	return (*MangaStatus)(s).UnmarshalJSON(b)
+}
+
+// legacyTypes caches types converted by legacyType.
+var legacyTypes = struct {
+	sync.Mutex
+	types map[reflect.Type]reflect.Type
+}{types: make(map[reflect.Type]reflect.Type)}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+func getLegacyType(t reflect.Type) (reflect.Type, bool) {
+	if t == nil {
+		return nil, false
+	}
+
+	legacyTypes.Lock()
+	defer legacyTypes.Unlock()
+	return legacyType(t)
+}
+
+// legacyType to get the same type but with legacy enums. Returns
+// false if the type doesn't contain any enum.
+func legacyType(t reflect.Type) (reflect.Type, bool) {
+	if lt, ok := legacyEnumTypes[t]; ok {
+		return lt, true
+	}
+
+	if lt, ok := legacyTypes.types[t]; ok {
+		return lt, lt != t
+	}
+
+	// Prevent infinite loop for recursive type.
+	legacyTypes.types[t] = t
+
+	lt := t
+	switch t.Kind() {
+	case reflect.Ptr:
+		if e, ok := legacyType(t.Elem()); ok {
+			lt = reflect.PtrTo(e)
+		}
+	case reflect.Slice:
+		if e, ok := legacyType(t.Elem()); ok {
+			lt = reflect.SliceOf(e)
+		}
+	case reflect.Array:
+		if e, ok := legacyType(t.Elem()); ok {
+			lt = reflect.ArrayOf(t.Len(), e)
+		}
+	case reflect.Map:
+		if e, ok := legacyType(t.Elem()); ok {
+			lt = reflect.MapOf(t.Key(), e)
+		}
+	case reflect.Struct:
+		// Keep types with their own encoding.
+		if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+			break
+		}
+
+		var changed bool
+		fields := make([]reflect.StructField, 0, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				// Unexported fields are not encoded.
+				continue
+			}
+			if ft, ok := legacyType(f.Type); ok {
+				f.Type = ft
+				changed = true
+			}
+			fields = append(fields, f)
+		}
+
+		if changed {
+			lt = reflect.StructOf(fields)
+		}
+	}
+
+	legacyTypes.types[t] = lt
+	return lt, lt != t
+}
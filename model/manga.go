@@ -13,10 +13,10 @@ type Manga struct {
 	Popularity        int              `json:"popularity"`
 	Member            int              `json:"member"`
 	Favorite          int              `json:"favorite"`
-	Type              string           `json:"type"`
+	Type              MangaType        `json:"type"`
 	Volume            int              `json:"volume"`
 	Chapter           int              `json:"chapter"`
-	Status            string           `json:"status"`
+	Status            MangaStatus      `json:"status"`
 	PublishingDate    StartEndDate     `json:"publishingDate"`
 	Genres            []Item           `json:"genres"`
 	Authors           []Item           `json:"authors"`
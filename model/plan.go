@@ -0,0 +1,31 @@
+package model
+
+// Plan represents MyAnimeList requests which would be sent
+// and cache usage recorded in dry-run mode.
+type Plan struct {
+	Requests []PlanRequest `json:"requests"`
+	Keys     []PlanKey     `json:"keys"`
+}
+
+// PlanRequest represents a MyAnimeList request which would be sent.
+//
+// Estimated is true if the request is the first page of a
+// multi-page request (all pages of user anime list, etc) or the
+// request may not be sent because it depends on the previous one.
+// Pages is the estimated page count of the multi-page request taken
+// from cached data (user stats, etc). Zero means unknown. The real
+// page count is only known after the pages are requested.
+type PlanRequest struct {
+	URL       string `json:"url"`
+	Estimated bool   `json:"estimated"`
+	Pages     int    `json:"pages,omitempty"`
+}
+
+// PlanKey represents the number of distinct cache keys of a key
+// prefix (`mal:anime`, etc) which are found (hit) and not found
+// (miss) in cache.
+type PlanKey struct {
+	Prefix string `json:"prefix"`
+	Hit    int    `json:"hit"`
+	Miss   int    `json:"miss"`
+}
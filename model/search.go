@@ -99,3 +99,25 @@ type UserSearch struct {
 	Image      string     `json:"image"`
 	LastOnline *time.Time `json:"lastOnline"`
 }
+
+// ResolveHint represents optional hint to help resolving
+// anime/manga title to its ID.
+type ResolveHint struct {
+	Year          int     // start airing/publishing year
+	Type          int     // anime/manga type constant
+	Episode       int     // episode count for anime, chapter count for manga
+	MinConfidence float64 // minimum confidence for best match (default 0.8)
+}
+
+// ResolveResult represents anime/manga title resolver result model.
+type ResolveResult struct {
+	Best       *ResolveCandidate  `json:"best"`
+	Candidates []ResolveCandidate `json:"candidates"`
+}
+
+// ResolveCandidate represents anime/manga matched with the title.
+type ResolveCandidate struct {
+	ID         int     `json:"id"`
+	Title      string  `json:"title"`
+	Confidence float64 `json:"confidence"`
+}
@@ -0,0 +1,10 @@
+package model
+
+// URLInfo represents parsed MyAnimeList URL.
+type URLInfo struct {
+	Entity   string `json:"entity"`
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	SubPage  string `json:"sub_page"`
+	Page     int    `json:"page"`
+}
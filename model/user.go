@@ -137,25 +137,25 @@ type Season struct {
 
 // UserAnime represents user anime model.
 type UserAnime struct {
-	ID           int        `json:"id"`
-	Title        string     `json:"title"`
-	Image        string     `json:"image"`
-	Score        int        `json:"score"`
-	Status       int        `json:"status"`
-	Type         string     `json:"type"`
-	Progress     int        `json:"progress"`
-	Episode      int        `json:"episode"`
-	Tag          string     `json:"tag"`
-	Rating       string     `json:"rating"`
-	AiringStatus int        `json:"airingStatus"`
-	AiringStart  Date       `json:"airingStart"`
-	AiringEnd    Date       `json:"airingEnd"`
-	WatchStart   *time.Time `json:"watchStart"`
-	WatchEnd     *time.Time `json:"watchEnd"`
-	IsRewatching bool       `json:"isRewatching"`
-	Days         int        `json:"days"`
-	Storage      string     `json:"storage"`
-	Priority     string     `json:"priority"`
+	ID           int         `json:"id"`
+	Title        string      `json:"title"`
+	Image        string      `json:"image"`
+	Score        int         `json:"score"`
+	Status       int         `json:"status"`
+	Type         AnimeType   `json:"type"`
+	Progress     int         `json:"progress"`
+	Episode      int         `json:"episode"`
+	Tag          string      `json:"tag"`
+	Rating       AnimeRating `json:"rating"`
+	AiringStatus int         `json:"airingStatus"`
+	AiringStart  Date        `json:"airingStart"`
+	AiringEnd    Date        `json:"airingEnd"`
+	WatchStart   *time.Time  `json:"watchStart"`
+	WatchEnd     *time.Time  `json:"watchEnd"`
+	IsRewatching bool        `json:"isRewatching"`
+	Days         int         `json:"days"`
+	Storage      string      `json:"storage"`
+	Priority     string      `json:"priority"`
 }
 
 // UserRawManga represents MyAnimeList raw JSON manga list response.
@@ -207,3 +207,48 @@ type UserManga struct {
 	Days             int        `json:"days"`
 	Priority         string     `json:"priority"`
 }
+
+// UserCompare represents anime/manga list comparison between 2 users.
+type UserCompare struct {
+	Shared              []UserCompareItem `json:"shared"`
+	OnlyFirstCompleted  []Item            `json:"onlyFirstCompleted"`
+	OnlySecondCompleted []Item            `json:"onlySecondCompleted"`
+	FirstMeanScore      float64           `json:"firstMeanScore"`
+	SecondMeanScore     float64           `json:"secondMeanScore"`
+	// Mean of absolute score difference of entries scored by both users.
+	MeanScoreDifference float64 `json:"meanScoreDifference"`
+	// Pearson correlation of the scores, -1 to 1.
+	Correlation float64 `json:"correlation"`
+	// Correlation as percentage, 0 to 100. Negative correlation is 0.
+	Compatibility float64 `json:"compatibility"`
+}
+
+// UserCompareItem represents anime/manga entry which exists in both user list.
+type UserCompareItem struct {
+	ID           int    `json:"id"`
+	Title        string `json:"title"`
+	FirstScore   int    `json:"firstScore"`
+	SecondScore  int    `json:"secondScore"`
+	FirstStatus  int    `json:"firstStatus"`
+	SecondStatus int    `json:"secondStatus"`
+}
+
+// UserAnimeBreakdown represents user anime list breakdown
+// by genre, studio, season, and type.
+type UserAnimeBreakdown struct {
+	Genres  []UserBreakdownItem `json:"genres"`
+	Studios []UserBreakdownItem `json:"studios"`
+	Seasons []UserBreakdownItem `json:"seasons"`
+	Types   []UserBreakdownItem `json:"types"`
+	Total   int                 `json:"total"`
+	Missing int                 `json:"missing"`
+}
+
+// UserBreakdownItem represents each breakdown group.
+type UserBreakdownItem struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	Count     int     `json:"count"`
+	MeanScore float64 `json:"meanScore"`
+	WatchTime int     `json:"watchTime"`
+}
@@ -0,0 +1,126 @@
+package breakdown
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/utils"
+)
+
+type group struct {
+	id         int
+	name       string
+	count      int
+	scoreSum   int
+	scoreCount int
+	watchTime  int
+}
+
+type groups map[string]*group
+
+func (g groups) add(id int, name string, score, watchTime int) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return
+	}
+
+	k := fmt.Sprintf("%d:%s", id, name)
+	if _, ok := g[k]; !ok {
+		g[k] = &group{id: id, name: name}
+	}
+
+	g[k].count++
+	g[k].watchTime += watchTime
+	if score > 0 {
+		g[k].scoreSum += score
+		g[k].scoreCount++
+	}
+}
+
+func (g groups) list() []model.UserBreakdownItem {
+	items := []model.UserBreakdownItem{}
+	for _, v := range g {
+		item := model.UserBreakdownItem{
+			ID:        v.id,
+			Name:      v.name,
+			Count:     v.count,
+			WatchTime: v.watchTime,
+		}
+		if v.scoreCount > 0 {
+			item.MeanScore = math.Round(float64(v.scoreSum)/float64(v.scoreCount)*100) / 100
+		}
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	return items
+}
+
+// Anime to group user anime list by genre, studio, season and type.
+// Param `details` is map of anime details with anime id as the key.
+// Anime without details will be counted as missing.
+func Anime(list []model.UserAnime, details map[int]model.Anime) model.UserAnimeBreakdown {
+	genres, studios, seasons, types := groups{}, groups{}, groups{}, groups{}
+
+	var missing int
+	for _, a := range list {
+		d, ok := details[a.ID]
+		if !ok {
+			missing++
+			continue
+		}
+
+		watchTime := a.Progress * utils.GetDuration(d.Duration)
+
+		for _, g := range d.Genres {
+			genres.add(g.ID, g.Name, a.Score, watchTime)
+		}
+
+		for _, s := range d.Studios {
+			studios.add(s.ID, s.Name, a.Score, watchTime)
+		}
+
+		seasons.add(0, getSeason(d), a.Score, watchTime)
+		if d.Type > 0 {
+			types.add(int(d.Type), d.Type.String(), a.Score, watchTime)
+		}
+	}
+
+	return model.UserAnimeBreakdown{
+		Genres:  genres.list(),
+		Studios: studios.list(),
+		Seasons: seasons.list(),
+		Types:   types.list(),
+		Total:   len(list),
+		Missing: missing,
+	}
+}
+
+// getSeason to get anime premiered season. Will use airing
+// start date if the anime doesn't have premiered season.
+func getSeason(a model.Anime) string {
+	if a.Premiered != "" && a.Premiered != "?" {
+		return a.Premiered
+	}
+
+	start := a.AiringDate.Start
+	if start.Year == 0 {
+		return ""
+	}
+
+	season := utils.GetSeasonName(start.Month)
+	if season == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s%s %d", strings.ToUpper(season[:1]), season[1:], start.Year)
+}
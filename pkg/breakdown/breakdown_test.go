@@ -0,0 +1,73 @@
+package breakdown
+
+import (
+	"testing"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnime(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, model.UserAnimeBreakdown{
+			Genres:  []model.UserBreakdownItem{},
+			Studios: []model.UserBreakdownItem{},
+			Seasons: []model.UserBreakdownItem{},
+			Types:   []model.UserBreakdownItem{},
+		}, Anime(nil, nil))
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		list := []model.UserAnime{
+			{ID: 1, Score: 10, Progress: 26},
+			{ID: 2, Score: 7, Progress: 1},
+			{ID: 3, Score: 0, Progress: 12},
+			{ID: 4, Score: 5},
+		}
+		details := map[int]model.Anime{
+			1: {
+				ID:        1,
+				Type:      model.AnimeTypeTV,
+				Premiered: "Spring 1998",
+				Duration:  "24 min. per ep.",
+				Genres:    []model.Item{{ID: 1, Name: "Action"}, {ID: 24, Name: "Sci-Fi"}},
+				Studios:   []model.Item{{ID: 14, Name: "Sunrise"}},
+			},
+			2: {
+				ID:         2,
+				Type:       model.AnimeTypeMovie,
+				Premiered:  "?",
+				AiringDate: model.StartEndDate{Start: model.Date{Year: 2001, Month: 9, Day: 1}},
+				Duration:   "1 hr. 55 min.",
+				Genres:     []model.Item{{ID: 1, Name: "Action"}},
+				Studios:    []model.Item{{ID: 14, Name: "Sunrise"}, {ID: 4, Name: "Bones"}},
+			},
+			3: {
+				ID:       3,
+				Type:     model.AnimeTypeTV,
+				Duration: "24 min. per ep.",
+				Genres:   []model.Item{{ID: 24, Name: "Sci-Fi"}},
+			},
+		}
+
+		d := Anime(list, details)
+		assert.Equal(t, 4, d.Total)
+		assert.Equal(t, 1, d.Missing)
+		assert.Equal(t, []model.UserBreakdownItem{
+			{ID: 1, Name: "Action", Count: 2, MeanScore: 8.5, WatchTime: 26*24*60 + 115*60},
+			{ID: 24, Name: "Sci-Fi", Count: 2, MeanScore: 10, WatchTime: 38 * 24 * 60},
+		}, d.Genres)
+		assert.Equal(t, []model.UserBreakdownItem{
+			{ID: 14, Name: "Sunrise", Count: 2, MeanScore: 8.5, WatchTime: 26*24*60 + 115*60},
+			{ID: 4, Name: "Bones", Count: 1, MeanScore: 7, WatchTime: 115 * 60},
+		}, d.Studios)
+		assert.Equal(t, []model.UserBreakdownItem{
+			{Name: "Spring 1998", Count: 1, MeanScore: 10, WatchTime: 26 * 24 * 60},
+			{Name: "Summer 2001", Count: 1, MeanScore: 7, WatchTime: 115 * 60},
+		}, d.Seasons)
+		assert.Equal(t, []model.UserBreakdownItem{
+			{ID: 1, Name: "TV", Count: 2, MeanScore: 10, WatchTime: 38 * 24 * 60},
+			{ID: 3, Name: "Movie", Count: 1, MeanScore: 7, WatchTime: 115 * 60},
+		}, d.Types)
+	})
+}
@@ -0,0 +1,11 @@
+// Package breakdown provides functions to group user anime list
+// by genre, studio, season, and type.
+//
+// User anime list doesn't contain the genre, duration, etc, so
+// the anime details are needed.
+//
+//	result := breakdown.Anime(list, details)
+//	for _, g := range result.Genres {
+//		fmt.Println(g.Name, g.Count, g.MeanScore)
+//	}
+package breakdown
@@ -0,0 +1,23 @@
+// Package filecache provides persistent cacher which stores each
+// entry as a file in a directory.
+//
+// File name is derived from the cache key. Each file contains a small
+// header with the entry's expired time followed by the JSON encoded
+// (and optionally gzipped) data. Writes go to a temporary file which is
+// then renamed so readers never see partially written entries.
+//
+// Directory size can be bounded by setting `Config.MaxSize`. A sweep
+// runs periodically removing expired entries then least recently used
+// entries until total size is under the limit. Entry's modification
+// time is used as its last access time.
+//
+// Several processes can share the same directory. The worst that can
+// happen is an entry being evicted a bit earlier than needed.
+//
+//	c, err := filecache.New("/tmp/malscraper", 24*time.Hour)
+//	if err != nil {
+//		// handle error
+//	}
+//
+//	m, err := malscraper.New(malscraper.Config{Cacher: c})
+package filecache
@@ -0,0 +1,449 @@
+package filecache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Filecache client implements Cacher interface.
+var _ service.Cacher = &Client{}
+
+// List of filecache errors.
+var (
+	ErrNotFound = errors.New("cache not found")
+	ErrExpired  = errors.New("cache expired")
+	ErrInvalid  = errors.New("invalid cache file")
+)
+
+// Testable time now func.
+var timeNow = time.Now
+
+const (
+	magic      = "mlc1"
+	headerSize = len(magic) + 1 + 8
+	flagGzip   = 1 << 0
+
+	tmpPrefix     = ".tmp-"
+	maxNameLength = 200
+	staleTmpAge   = time.Hour
+)
+
+// Config is filecache config.
+type Config struct {
+	// Directory to store cache files. Will be created if not exists.
+	Dir string
+	// Entry expired time. Zero means entries never expire.
+	TTL time.Duration
+	// Compress entries with gzip.
+	Gzip bool
+	// Maximum total size of entries in bytes. Zero means unlimited.
+	MaxSize int64
+	// Interval between eviction sweeps. Default is 1 minute.
+	// Negative value disables the periodic sweep.
+	SweepInterval time.Duration
+}
+
+// Client is filecache client.
+type Client struct {
+	dir     string
+	ttl     time.Duration
+	gzip    bool
+	maxSize int64
+
+	sweepMu   sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// New to create new file cache with default config.
+func New(dir string, expiredTime time.Duration) (*Client, error) {
+	return NewWithConfig(Config{
+		Dir: dir,
+		TTL: expiredTime,
+	})
+}
+
+// NewWithConfig to create new file cache with config.
+func NewWithConfig(cfg Config) (*Client, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("empty cache dir")
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, err
+	}
+
+	if cfg.SweepInterval == 0 {
+		cfg.SweepInterval = time.Minute
+	}
+
+	c := &Client{
+		dir:     cfg.Dir,
+		ttl:     cfg.TTL,
+		gzip:    cfg.Gzip,
+		maxSize: cfg.MaxSize,
+		done:    make(chan struct{}),
+	}
+
+	if cfg.SweepInterval > 0 {
+		c.wg.Add(1)
+		go c.sweeper(cfg.SweepInterval)
+	}
+
+	return c, nil
+}
+
+// Get to get data from cache.
+func (c *Client) Get(key string, data interface{}) error {
+	path := c.path(key)
+
+	d, fi, err := readFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	flag, expiredAt, err := readHeader(d)
+	if err != nil {
+		return err
+	}
+
+	if isExpired(expiredAt) {
+		_ = c.remove(path, fi)
+		return ErrExpired
+	}
+
+	d = d[headerSize:]
+	if flag&flagGzip != 0 {
+		if d, err = gunzip(d); err != nil {
+			return err
+		}
+	}
+
+	if err = json.Unmarshal(d, &data); err != nil {
+		return err
+	}
+
+	// Mark as recently used.
+	now := timeNow()
+	_ = os.Chtimes(path, now, now)
+
+	return nil
+}
+
+// Set to save data to cache.
+func (c *Client) Set(key string, data interface{}) error {
+	d, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	var flag byte
+	if c.gzip {
+		flag |= flagGzip
+		if d, err = compress(d); err != nil {
+			return err
+		}
+	}
+
+	var expiredAt int64
+	if c.ttl > 0 {
+		expiredAt = timeNow().Add(c.ttl).UnixNano()
+	}
+
+	return c.write(c.path(key), append(writeHeader(flag, expiredAt), d...))
+}
+
+// Delete to delete data from cache.
+func (c *Client) Delete(key string) error {
+	err := os.Remove(c.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Close to stop the sweeper. Cache files are kept.
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	c.wg.Wait()
+	return nil
+}
+
+// write to temporary file first then rename it so
+// other readers never see half-written file.
+func (c *Client) write(path string, d []byte) error {
+	f, err := ioutil.TempFile(c.dir, tmpPrefix)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(d); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err = os.Chmod(f.Name(), 0644); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err = os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return nil
+}
+
+// remove to remove the cache file only if it is still the same
+// file as the checked one. New entry may be written to the same
+// path after the check and should not be removed.
+func (c *Client) remove(path string, checked os.FileInfo) error {
+	// Move whatever in the path to a temporary file first
+	// so it can be checked without racing with writers.
+	f, err := ioutil.TempFile(c.dir, tmpPrefix)
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	f.Close()
+
+	if err = os.Rename(path, tmp); err != nil {
+		os.Remove(tmp)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if fi, err := os.Stat(tmp); err == nil && os.SameFile(checked, fi) {
+		return os.Remove(tmp)
+	}
+
+	// Newer entry is moved. Put it back unless there is
+	// an even newer one already.
+	if err = os.Link(tmp, path); err != nil && !os.IsExist(err) {
+		return os.Rename(tmp, path)
+	}
+
+	return os.Remove(tmp)
+}
+
+func (c *Client) path(key string) string {
+	return filepath.Join(c.dir, fileName(key))
+}
+
+// fileName to convert cache key to safe file name. Keys too
+// long for file system will be truncated and suffixed with
+// its hash to keep them unique.
+func fileName(key string) string {
+	name := url.QueryEscape(key)
+	if strings.HasPrefix(name, ".") {
+		name = "%2E" + name[1:]
+	}
+
+	if len(name) <= maxNameLength {
+		return name
+	}
+
+	h := sha1.Sum([]byte(key))
+	return name[:maxNameLength-len(h)*2-1] + "-" + hex.EncodeToString(h[:])
+}
+
+func writeHeader(flag byte, expiredAt int64) []byte {
+	h := make([]byte, headerSize)
+	copy(h, magic)
+	h[len(magic)] = flag
+	binary.BigEndian.PutUint64(h[len(magic)+1:], uint64(expiredAt))
+	return h
+}
+
+func readHeader(d []byte) (flag byte, expiredAt int64, err error) {
+	if len(d) < headerSize || string(d[:len(magic)]) != magic {
+		return 0, 0, ErrInvalid
+	}
+	return d[len(magic)], int64(binary.BigEndian.Uint64(d[len(magic)+1 : headerSize])), nil
+}
+
+func isExpired(expiredAt int64) bool {
+	return expiredAt != 0 && timeNow().UnixNano() >= expiredAt
+}
+
+func compress(d []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(d); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzip(d []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(d))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+func (c *Client) sweeper(interval time.Duration) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			_ = c.Sweep()
+		}
+	}
+}
+
+// Sweep to remove expired entries, stale temporary files and,
+// if total size exceeds `Config.MaxSize`, least recently used
+// entries. Automatically called periodically but can also be
+// called manually.
+func (c *Client) Sweep() error {
+	c.sweepMu.Lock()
+	defer c.sweepMu.Unlock()
+
+	files, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	var size int64
+	type entry struct {
+		path string
+		info os.FileInfo
+	}
+	entries := make([]entry, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+
+		path := filepath.Join(c.dir, f.Name())
+
+		if strings.HasPrefix(f.Name(), tmpPrefix) {
+			if timeNow().Sub(f.ModTime()) > staleTmpAge {
+				_ = os.Remove(path)
+			}
+			continue
+		}
+
+		fi, ok, expired := readFileHeader(path)
+		if !ok {
+			// Not a cache file, leave it be.
+			continue
+		}
+
+		if expired {
+			_ = c.remove(path, fi)
+			continue
+		}
+
+		size += fi.Size()
+		entries = append(entries, entry{path: path, info: fi})
+	}
+
+	if c.maxSize <= 0 || size <= c.maxSize {
+		return nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].info.ModTime().Before(entries[j].info.ModTime())
+	})
+
+	for _, e := range entries {
+		if size <= c.maxSize {
+			break
+		}
+		if err := c.remove(e.path, e.info); err != nil {
+			return err
+		}
+		size -= e.info.Size()
+	}
+
+	return nil
+}
+
+// readFile to read file and get info of the same opened file.
+func readFile(path string) ([]byte, os.FileInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return d, fi, nil
+}
+
+func readFileHeader(path string) (fi os.FileInfo, ok bool, expired bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, false, false
+	}
+	defer f.Close()
+
+	if fi, err = f.Stat(); err != nil {
+		return nil, false, false
+	}
+
+	h := make([]byte, headerSize)
+	if _, err = io.ReadFull(f, h); err != nil {
+		return nil, false, false
+	}
+
+	_, expiredAt, err := readHeader(h)
+	if err != nil {
+		return nil, false, false
+	}
+
+	return fi, true, isExpired(expiredAt)
+}
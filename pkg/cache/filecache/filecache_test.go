@@ -0,0 +1,287 @@
+package filecache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sample struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+func newClient(t *testing.T, cfg Config) *Client {
+	cfg.Dir = t.TempDir()
+	if cfg.SweepInterval == 0 {
+		cfg.SweepInterval = -1
+	}
+	c, err := NewWithConfig(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func setNow(t *testing.T, now time.Time) {
+	old := timeNow
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = old })
+}
+
+func TestNew(t *testing.T) {
+	_, err := New("", time.Hour)
+	assert.Error(t, err)
+
+	dir := filepath.Join(t.TempDir(), "a", "b")
+	c, err := New(dir, time.Hour)
+	require.NoError(t, err)
+	assert.DirExists(t, dir)
+	assert.NoError(t, c.Close())
+	assert.NoError(t, c.Close())
+}
+
+func TestClient(t *testing.T) {
+	for _, gz := range []bool{false, true} {
+		c := newClient(t, Config{TTL: time.Hour, Gzip: gz})
+
+		var d sample
+		assert.Equal(t, ErrNotFound, c.Get("mal:anime:1", &d))
+
+		require.NoError(t, c.Set("mal:anime:1", sample{ID: 1, Title: "Cowboy Bebop"}))
+		require.NoError(t, c.Get("mal:anime:1", &d))
+		assert.Equal(t, sample{ID: 1, Title: "Cowboy Bebop"}, d)
+
+		require.NoError(t, c.Delete("mal:anime:1"))
+		assert.Equal(t, ErrNotFound, c.Get("mal:anime:1", &d))
+		assert.NoError(t, c.Delete("mal:anime:1"))
+	}
+}
+
+func TestClientMixedGzip(t *testing.T) {
+	dir := t.TempDir()
+
+	c1, err := NewWithConfig(Config{Dir: dir, Gzip: true, SweepInterval: -1})
+	require.NoError(t, err)
+	c2, err := NewWithConfig(Config{Dir: dir, SweepInterval: -1})
+	require.NoError(t, err)
+
+	require.NoError(t, c1.Set("key", sample{ID: 1}))
+
+	var d sample
+	require.NoError(t, c2.Get("key", &d))
+	assert.Equal(t, 1, d.ID)
+}
+
+func TestClientExpired(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	setNow(t, now)
+
+	c := newClient(t, Config{TTL: time.Hour})
+	require.NoError(t, c.Set("key", sample{ID: 1}))
+
+	setNow(t, now.Add(2*time.Hour))
+
+	var d sample
+	assert.Equal(t, ErrExpired, c.Get("key", &d))
+	assert.NoFileExists(t, c.path("key"))
+
+	// No TTL.
+	c = newClient(t, Config{})
+	require.NoError(t, c.Set("key", sample{ID: 1}))
+	setNow(t, now.Add(100*time.Hour))
+	assert.NoError(t, c.Get("key", &d))
+}
+
+func TestClientInvalid(t *testing.T) {
+	c := newClient(t, Config{})
+	require.NoError(t, ioutil.WriteFile(c.path("key"), []byte("abc"), 0644))
+
+	var d sample
+	assert.Equal(t, ErrInvalid, c.Get("key", &d))
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t, "mal%3Aanime%3A1", fileName("mal:anime:1"))
+	assert.Equal(t, "mal%3Asearch-anime%3Anaruto+shippuden%2F1", fileName("mal:search-anime:naruto shippuden/1"))
+	assert.Equal(t, "%2E.", fileName(".."))
+
+	long1 := fileName("mal:" + strings.Repeat("a", 300) + "1")
+	long2 := fileName("mal:" + strings.Repeat("a", 300) + "2")
+	assert.Len(t, long1, maxNameLength)
+	assert.NotEqual(t, long1, long2)
+}
+
+func TestSweep(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	setNow(t, now)
+
+	c := newClient(t, Config{TTL: time.Hour})
+
+	require.NoError(t, c.Set("expired", sample{ID: 1}))
+	setNow(t, now.Add(30*time.Minute))
+	require.NoError(t, c.Set("fresh", sample{ID: 2}))
+
+	stale := filepath.Join(c.dir, tmpPrefix+"stale")
+	require.NoError(t, ioutil.WriteFile(stale, []byte("abc"), 0644))
+	require.NoError(t, os.Chtimes(stale, now.Add(-2*time.Hour), now.Add(-2*time.Hour)))
+
+	other := filepath.Join(c.dir, "other")
+	require.NoError(t, ioutil.WriteFile(other, []byte("abc"), 0644))
+
+	setNow(t, now.Add(61*time.Minute))
+	require.NoError(t, c.Sweep())
+
+	assert.NoFileExists(t, c.path("expired"))
+	assert.FileExists(t, c.path("fresh"))
+	assert.NoFileExists(t, stale)
+	assert.FileExists(t, other)
+}
+
+func TestSweepLRU(t *testing.T) {
+	c := newClient(t, Config{})
+
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	keys := []string{"a", "b", "c", "d"}
+	for i, k := range keys {
+		require.NoError(t, c.Set(k, sample{ID: i, Title: strings.Repeat("x", 100)}))
+		tm := now.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, os.Chtimes(c.path(k), tm, tm))
+	}
+
+	// Access "a" so it becomes the most recently used.
+	setNow(t, now.Add(time.Hour))
+	var d sample
+	require.NoError(t, c.Get("a", &d))
+
+	info, err := os.Stat(c.path("a"))
+	require.NoError(t, err)
+	c.maxSize = info.Size() * 2
+
+	require.NoError(t, c.Sweep())
+
+	assert.FileExists(t, c.path("a"))
+	assert.FileExists(t, c.path("d"))
+	assert.NoFileExists(t, c.path("b"))
+	assert.NoFileExists(t, c.path("c"))
+}
+
+func TestSweeper(t *testing.T) {
+	c, err := NewWithConfig(Config{Dir: t.TempDir(), SweepInterval: time.Millisecond, MaxSize: 1})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Set("key", sample{ID: 1}))
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(c.path("key"))
+		return os.IsNotExist(err)
+	}, time.Second, time.Millisecond)
+
+	assert.NoError(t, c.Close())
+}
+
+func TestConcurrent(t *testing.T) {
+	dir := t.TempDir()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		c, err := NewWithConfig(Config{Dir: dir, Gzip: i%2 == 0, SweepInterval: -1})
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func(c *Client, i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				assert.NoError(t, c.Set("key", sample{ID: i, Title: strings.Repeat("x", j)}))
+
+				var d sample
+				err := c.Get("key", &d)
+				assert.True(t, err == nil || err == ErrNotFound, err)
+				if j%5 == 0 {
+					assert.NoError(t, c.Sweep())
+				}
+			}
+		}(c, i)
+	}
+	wg.Wait()
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestRemoveRewritten(t *testing.T) {
+	c := newClient(t, Config{})
+	require.NoError(t, c.Set("key", sample{ID: 1}))
+
+	path := c.path("key")
+	old, err := os.Stat(path)
+	require.NoError(t, err)
+
+	// Rewritten after being checked.
+	require.NoError(t, c.Set("key", sample{ID: 2}))
+	require.NoError(t, c.remove(path, old))
+
+	var d sample
+	require.NoError(t, c.Get("key", &d))
+	assert.Equal(t, 2, d.ID)
+
+	cur, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, c.remove(path, cur))
+	assert.NoFileExists(t, path)
+
+	files, err := ioutil.ReadDir(c.dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestConcurrentSweep(t *testing.T) {
+	dir := t.TempDir()
+
+	// Entries written by expired client are already expired
+	// when read.
+	expired, err := NewWithConfig(Config{Dir: dir, TTL: time.Nanosecond, SweepInterval: -1})
+	require.NoError(t, err)
+	fresh, err := NewWithConfig(Config{Dir: dir, TTL: time.Hour, SweepInterval: -1})
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			assert.NoError(t, expired.Set("key", sample{ID: i}))
+			assert.NoError(t, fresh.Set("key", sample{ID: i}))
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				assert.NoError(t, fresh.Sweep())
+				var d sample
+				_ = fresh.Get("key", &d)
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	// Last entry is not expired and must not be removed.
+	var d sample
+	require.NoError(t, fresh.Get("key", &d))
+	assert.Equal(t, 49, d.ID)
+}
@@ -0,0 +1,22 @@
+// Package tiered provides 2-level cacher. A small in-process L1
+// cache sits in front of any `service.Cacher` as L2, for example
+// a shared redis used by several replicas.
+//
+// L1 is bounded by number of entries and total bytes, evicting least
+// recently used entries, and has its own (usually short) expired time.
+// `Get` fills L1 on L2 hit and `Set` writes to both levels.
+//
+// To keep L1 of other replicas fresh, set `Config.OnInvalidate` to
+// broadcast the changed key (pub/sub, etc) and call `Invalidate()` on
+// the receiving replicas.
+//
+//	l2, _ := redis.New(addr, password, 24*time.Hour)
+//	c, err := tiered.NewWithConfig(tiered.Config{
+//		L2:         l2,
+//		TTL:        time.Minute,
+//		MaxEntries: 1000,
+//		OnInvalidate: func(key string) {
+//			pubsub.Publish("malscraper-invalidate", key)
+//		},
+//	})
+package tiered
@@ -0,0 +1,223 @@
+package tiered
+
+import (
+	"container/list"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Tiered client implements Cacher interface.
+var _ service.Cacher = &Client{}
+
+// Testable time now func.
+var timeNow = time.Now
+
+// Default L1 config.
+const (
+	DefaultTTL        = time.Minute
+	DefaultMaxEntries = 10000
+	DefaultMaxBytes   = 64 << 20
+)
+
+// Config is tiered cache config.
+type Config struct {
+	// L2 cacher. Required.
+	L2 service.Cacher
+	// L1 expired time.
+	TTL time.Duration
+	// Maximum number of entries in L1.
+	MaxEntries int
+	// Maximum total size of L1 entries in bytes.
+	MaxBytes int64
+	// Called with the key after `Set` and `Delete`. Use it to
+	// tell other replicas to invalidate their L1.
+	OnInvalidate func(key string)
+}
+
+// Client is tiered cache client.
+type Client struct {
+	l2           service.Cacher
+	ttl          time.Duration
+	maxEntries   int
+	maxBytes     int64
+	onInvalidate func(key string)
+
+	mu    sync.Mutex
+	items map[string]*list.Element
+	lru   *list.List
+	size  int64
+}
+
+type item struct {
+	key       string
+	data      []byte
+	expiredAt time.Time
+}
+
+// New to create new tiered cache with default L1 config.
+func New(l2 service.Cacher) (*Client, error) {
+	return NewWithConfig(Config{L2: l2})
+}
+
+// NewWithConfig to create new tiered cache with config.
+// Zero L1 config will use the default value.
+func NewWithConfig(cfg Config) (*Client, error) {
+	if cfg.L2 == nil {
+		return nil, errors.New("nil L2 cacher")
+	}
+
+	if cfg.TTL <= 0 {
+		cfg.TTL = DefaultTTL
+	}
+
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultMaxEntries
+	}
+
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = DefaultMaxBytes
+	}
+
+	return &Client{
+		l2:           cfg.L2,
+		ttl:          cfg.TTL,
+		maxEntries:   cfg.MaxEntries,
+		maxBytes:     cfg.MaxBytes,
+		onInvalidate: cfg.OnInvalidate,
+		items:        make(map[string]*list.Element),
+		lru:          list.New(),
+	}, nil
+}
+
+// Get to get data from L1 then L2. L2 hit will be saved to L1.
+func (c *Client) Get(key string, data interface{}) error {
+	if d, ok := c.get(key); ok {
+		return json.Unmarshal(d, &data)
+	}
+
+	if err := c.l2.Get(key, data); err != nil {
+		return err
+	}
+
+	if d, err := json.Marshal(data); err == nil {
+		c.set(key, d)
+	}
+
+	return nil
+}
+
+// Set to save data to L1 and L2.
+func (c *Client) Set(key string, data interface{}) error {
+	d, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if err = c.l2.Set(key, data); err != nil {
+		c.Invalidate(key)
+		return err
+	}
+
+	c.set(key, d)
+	c.invalidate(key)
+
+	return nil
+}
+
+// Delete to delete data from L1 and L2.
+func (c *Client) Delete(key string) error {
+	c.Invalidate(key)
+	if err := c.l2.Delete(key); err != nil {
+		return err
+	}
+	c.invalidate(key)
+	return nil
+}
+
+// Close to clear L1 and close L2 connection.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	c.items = make(map[string]*list.Element)
+	c.lru.Init()
+	c.size = 0
+	c.mu.Unlock()
+	return c.l2.Close()
+}
+
+// Invalidate to delete data from L1 only. Call this when
+// receiving invalidation broadcast from other replicas.
+func (c *Client) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.remove(e)
+	}
+}
+
+// Len to get number of entries and total bytes in L1.
+func (c *Client) Len() (int, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len(), c.size
+}
+
+func (c *Client) invalidate(key string) {
+	if c.onInvalidate != nil {
+		c.onInvalidate(key)
+	}
+}
+
+func (c *Client) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	i := e.Value.(*item)
+	if !timeNow().Before(i.expiredAt) {
+		c.remove(e)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(e)
+	return i.data, true
+}
+
+func (c *Client) set(key string, d []byte) {
+	// Too big to be cached.
+	if int64(len(d)) > c.maxBytes {
+		c.Invalidate(key)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		c.remove(e)
+	}
+
+	c.items[key] = c.lru.PushFront(&item{
+		key:       key,
+		data:      d,
+		expiredAt: timeNow().Add(c.ttl),
+	})
+	c.size += int64(len(d))
+
+	for c.lru.Len() > c.maxEntries || c.size > c.maxBytes {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *Client) remove(e *list.Element) {
+	i := c.lru.Remove(e).(*item)
+	delete(c.items, i.key)
+	c.size -= int64(len(i.data))
+}
@@ -0,0 +1,193 @@
+package tiered
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var errDummy = errors.New("dummy error")
+
+// memCacher is simple L2 cacher which counts its calls.
+type memCacher struct {
+	data map[string][]byte
+	get  int
+}
+
+func newMemCacher() *memCacher {
+	return &memCacher{data: make(map[string][]byte)}
+}
+
+func (m *memCacher) Get(key string, data interface{}) error {
+	m.get++
+	d, ok := m.data[key]
+	if !ok {
+		return errDummy
+	}
+	return json.Unmarshal(d, data)
+}
+
+func (m *memCacher) Set(key string, data interface{}) error {
+	d, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	m.data[key] = d
+	return nil
+}
+
+func (m *memCacher) Delete(key string) error {
+	delete(m.data, key)
+	return nil
+}
+
+func (m *memCacher) Close() error {
+	return nil
+}
+
+type sample struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+func setNow(t *testing.T, now time.Time) {
+	old := timeNow
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = old })
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(nil)
+	assert.Error(t, err)
+
+	c, err := New(newMemCacher())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTTL, c.ttl)
+	assert.Equal(t, DefaultMaxEntries, c.maxEntries)
+	assert.Equal(t, int64(DefaultMaxBytes), c.maxBytes)
+}
+
+func TestClient(t *testing.T) {
+	l2 := newMemCacher()
+	var invalidated []string
+	c, err := NewWithConfig(Config{
+		L2:           l2,
+		OnInvalidate: func(key string) { invalidated = append(invalidated, key) },
+	})
+	require.NoError(t, err)
+
+	var d sample
+	assert.Equal(t, errDummy, c.Get("key", &d))
+
+	// Write through.
+	require.NoError(t, c.Set("key", sample{ID: 1, Title: "a"}))
+	assert.Contains(t, l2.data, "key")
+	assert.Equal(t, []string{"key"}, invalidated)
+
+	// L1 hit.
+	l2.get = 0
+	require.NoError(t, c.Get("key", &d))
+	assert.Equal(t, sample{ID: 1, Title: "a"}, d)
+	assert.Equal(t, 0, l2.get)
+
+	// L2 hit fills L1.
+	require.NoError(t, l2.Set("key2", sample{ID: 2}))
+	require.NoError(t, c.Get("key2", &d))
+	require.NoError(t, c.Get("key2", &d))
+	assert.Equal(t, 2, d.ID)
+	assert.Equal(t, 1, l2.get)
+
+	// Delete both.
+	require.NoError(t, c.Delete("key"))
+	assert.NotContains(t, l2.data, "key")
+	assert.Equal(t, errDummy, c.Get("key", &d))
+	assert.Equal(t, []string{"key", "key"}, invalidated)
+
+	// Invalidate L1 only.
+	c.Invalidate("key2")
+	l2.get = 0
+	require.NoError(t, c.Get("key2", &d))
+	assert.Equal(t, 1, l2.get)
+
+	assert.NoError(t, c.Close())
+	n, size := c.Len()
+	assert.Zero(t, n)
+	assert.Zero(t, size)
+}
+
+func TestClientTTL(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	setNow(t, now)
+
+	l2 := newMemCacher()
+	c, err := NewWithConfig(Config{L2: l2, TTL: time.Minute})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Set("key", sample{ID: 1}))
+
+	setNow(t, now.Add(2*time.Minute))
+	l2.get = 0
+
+	var d sample
+	require.NoError(t, c.Get("key", &d))
+	assert.Equal(t, 1, l2.get)
+}
+
+func TestClientBound(t *testing.T) {
+	c, err := NewWithConfig(Config{L2: newMemCacher(), MaxEntries: 2})
+	require.NoError(t, err)
+
+	var d sample
+	require.NoError(t, c.Set("a", sample{ID: 1}))
+	require.NoError(t, c.Set("b", sample{ID: 2}))
+	require.NoError(t, c.Get("a", &d))
+	require.NoError(t, c.Set("c", sample{ID: 3}))
+
+	n, _ := c.Len()
+	assert.Equal(t, 2, n)
+	assert.Contains(t, c.items, "a")
+	assert.Contains(t, c.items, "c")
+	assert.NotContains(t, c.items, "b")
+
+	// Bytes.
+	c, err = NewWithConfig(Config{L2: newMemCacher(), MaxBytes: 100})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Set("a", sample{Title: strings.Repeat("a", 40)}))
+	require.NoError(t, c.Set("b", sample{Title: strings.Repeat("b", 40)}))
+	assert.NotContains(t, c.items, "a")
+	assert.Contains(t, c.items, "b")
+
+	// Too big.
+	require.NoError(t, c.Set("c", sample{Title: strings.Repeat("c", 200)}))
+	assert.NotContains(t, c.items, "c")
+
+	_, size := c.Len()
+	assert.LessOrEqual(t, size, int64(100))
+}
+
+func TestClientError(t *testing.T) {
+	l2 := new(mocks.Cacher)
+	l2.On("Set", "key", mock.Anything).Return(errDummy)
+	l2.On("Delete", "key").Return(errDummy)
+	l2.On("Close").Return(errDummy)
+
+	invalidated := 0
+	c, err := NewWithConfig(Config{L2: l2, OnInvalidate: func(string) { invalidated++ }})
+	require.NoError(t, err)
+
+	assert.Equal(t, errDummy, c.Set("key", sample{ID: 1}))
+	assert.NotContains(t, c.items, "key")
+	assert.Equal(t, errDummy, c.Delete("key"))
+	assert.Equal(t, errDummy, c.Close())
+	assert.Zero(t, invalidated)
+
+	assert.Error(t, c.Set("key", func() {}))
+}
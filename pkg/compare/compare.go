@@ -0,0 +1,167 @@
+package compare
+
+import (
+	"math"
+	"sort"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/utils"
+)
+
+const statusCompleted = 2
+
+type entry struct {
+	id     int
+	title  string
+	score  int
+	status int
+}
+
+// Anime to compare 2 users' anime list.
+func Anime(first, second []model.UserAnime) model.UserCompare {
+	return compare(animeEntries(first), animeEntries(second))
+}
+
+// Manga to compare 2 users' manga list.
+func Manga(first, second []model.UserManga) model.UserCompare {
+	return compare(mangaEntries(first), mangaEntries(second))
+}
+
+func animeEntries(list []model.UserAnime) []entry {
+	entries := make([]entry, len(list))
+	for i, a := range list {
+		entries[i] = entry{id: a.ID, title: a.Title, score: a.Score, status: a.Status}
+	}
+	return entries
+}
+
+func mangaEntries(list []model.UserManga) []entry {
+	entries := make([]entry, len(list))
+	for i, m := range list {
+		entries[i] = entry{id: m.ID, title: m.Title, score: m.Score, status: m.Status}
+	}
+	return entries
+}
+
+func compare(first, second []entry) model.UserCompare {
+	secondMap := make(map[int]entry)
+	for _, e := range second {
+		secondMap[e.id] = e
+	}
+
+	firstMap := make(map[int]entry)
+	for _, e := range first {
+		firstMap[e.id] = e
+	}
+
+	result := model.UserCompare{
+		Shared:              []model.UserCompareItem{},
+		OnlyFirstCompleted:  onlyCompleted(first, secondMap),
+		OnlySecondCompleted: onlyCompleted(second, firstMap),
+	}
+
+	// Only entries scored by both users are used for score calculation.
+	var x, y []float64
+	for _, e1 := range first {
+		e2, ok := secondMap[e1.id]
+		if !ok {
+			continue
+		}
+
+		result.Shared = append(result.Shared, model.UserCompareItem{
+			ID:           e1.id,
+			Title:        e1.title,
+			FirstScore:   e1.score,
+			SecondScore:  e2.score,
+			FirstStatus:  e1.status,
+			SecondStatus: e2.status,
+		})
+
+		if e1.score > 0 && e2.score > 0 {
+			x = append(x, float64(e1.score))
+			y = append(y, float64(e2.score))
+		}
+	}
+
+	sort.Slice(result.Shared, func(i, j int) bool {
+		return result.Shared[i].Title < result.Shared[j].Title
+	})
+
+	result.FirstMeanScore = round(mean(x))
+	result.SecondMeanScore = round(mean(y))
+	result.MeanScoreDifference = round(meanAbsDiff(x, y))
+	correlation := pearson(x, y)
+	result.Correlation = round(correlation)
+	result.Compatibility = utils.GetPercent(math.Max(correlation, 0), 1.0, 1)
+
+	return result
+}
+
+func onlyCompleted(list []entry, other map[int]entry) []model.Item {
+	items := []model.Item{}
+	for _, e := range list {
+		if e.status != statusCompleted {
+			continue
+		}
+		if o, ok := other[e.id]; ok && o.status == statusCompleted {
+			continue
+		}
+		items = append(items, model.Item{ID: e.id, Name: e.title})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+	return items
+}
+
+func mean(x []float64) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range x {
+		sum += v
+	}
+	return sum / float64(len(x))
+}
+
+// Mean of absolute score difference of each entry so
+// opposite disagreements don't cancel each other out.
+func meanAbsDiff(x, y []float64) float64 {
+	if len(x) == 0 || len(x) != len(y) {
+		return 0
+	}
+	var sum float64
+	for i := range x {
+		sum += math.Abs(x[i] - y[i])
+	}
+	return sum / float64(len(x))
+}
+
+// Pearson correlation coefficient. Will return 0 if
+// there is not enough data or one of the score doesn't vary.
+func pearson(x, y []float64) float64 {
+	if len(x) < 2 || len(x) != len(y) {
+		return 0
+	}
+
+	mx, my := mean(x), mean(y)
+
+	var cov, vx, vy float64
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		cov += dx * dy
+		vx += dx * dx
+		vy += dy * dy
+	}
+
+	if vx == 0 || vy == 0 {
+		return 0
+	}
+
+	return cov / math.Sqrt(vx*vy)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
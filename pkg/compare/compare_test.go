@@ -0,0 +1,78 @@
+package compare
+
+import (
+	"testing"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnime(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, model.UserCompare{
+			Shared:              []model.UserCompareItem{},
+			OnlyFirstCompleted:  []model.Item{},
+			OnlySecondCompleted: []model.Item{},
+		}, Anime(nil, nil))
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		first := []model.UserAnime{
+			{ID: 1, Title: "A", Score: 10, Status: 2},
+			{ID: 2, Title: "B", Score: 8, Status: 2},
+			{ID: 3, Title: "C", Score: 6, Status: 1},
+			{ID: 4, Title: "D", Score: 0, Status: 2},
+			{ID: 5, Title: "E", Score: 7, Status: 2},
+		}
+		second := []model.UserAnime{
+			{ID: 3, Title: "C", Score: 5, Status: 2},
+			{ID: 2, Title: "B", Score: 7, Status: 2},
+			{ID: 1, Title: "A", Score: 9, Status: 2},
+			{ID: 4, Title: "D", Score: 4, Status: 1},
+			{ID: 6, Title: "F", Score: 3, Status: 2},
+		}
+
+		d := Anime(first, second)
+		assert.Equal(t, []model.UserCompareItem{
+			{ID: 1, Title: "A", FirstScore: 10, SecondScore: 9, FirstStatus: 2, SecondStatus: 2},
+			{ID: 2, Title: "B", FirstScore: 8, SecondScore: 7, FirstStatus: 2, SecondStatus: 2},
+			{ID: 3, Title: "C", FirstScore: 6, SecondScore: 5, FirstStatus: 1, SecondStatus: 2},
+			{ID: 4, Title: "D", FirstScore: 0, SecondScore: 4, FirstStatus: 2, SecondStatus: 1},
+		}, d.Shared)
+		assert.Equal(t, []model.Item{{ID: 4, Name: "D"}, {ID: 5, Name: "E"}}, d.OnlyFirstCompleted)
+		assert.Equal(t, []model.Item{{ID: 3, Name: "C"}, {ID: 6, Name: "F"}}, d.OnlySecondCompleted)
+		assert.Equal(t, 8.0, d.FirstMeanScore)
+		assert.Equal(t, 7.0, d.SecondMeanScore)
+		assert.Equal(t, 1.0, d.MeanScoreDifference)
+		assert.Equal(t, 1.0, d.Correlation)
+		assert.Equal(t, 100.0, d.Compatibility)
+	})
+}
+
+func TestManga(t *testing.T) {
+	first := []model.UserManga{
+		{ID: 1, Title: "A", Score: 10, Status: 2},
+		{ID: 2, Title: "B", Score: 5, Status: 2},
+		{ID: 3, Title: "C", Score: 1, Status: 2},
+	}
+	second := []model.UserManga{
+		{ID: 1, Title: "A", Score: 2, Status: 2},
+		{ID: 2, Title: "B", Score: 6, Status: 2},
+		{ID: 3, Title: "C", Score: 9, Status: 2},
+	}
+
+	d := Manga(first, second)
+	assert.Len(t, d.Shared, 3)
+	assert.Empty(t, d.OnlyFirstCompleted)
+	assert.Empty(t, d.OnlySecondCompleted)
+	assert.Equal(t, 5.67, d.MeanScoreDifference)
+	assert.Equal(t, -1.0, d.Correlation)
+	assert.Equal(t, 0.0, d.Compatibility)
+}
+
+func TestPearson(t *testing.T) {
+	assert.Equal(t, 0.0, pearson([]float64{1}, []float64{1}))
+	assert.Equal(t, 0.0, pearson([]float64{1, 2}, []float64{1}))
+	assert.Equal(t, 0.0, pearson([]float64{5, 5}, []float64{1, 2}))
+	assert.Equal(t, 1.0, pearson([]float64{1, 2, 3}, []float64{2, 4, 6}))
+}
@@ -0,0 +1,10 @@
+// Package compare provides functions to compare 2 users'
+// anime/manga list.
+//
+// The lists can be taken from malscraper `GetUserAnime()` and
+// `GetUserManga()` or from decoded MyAnimeList XML export file
+// so the comparison can be done offline.
+//
+//	result := compare.Anime(list1, list2)
+//	fmt.Println(result.Compatibility)
+package compare
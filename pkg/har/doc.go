@@ -0,0 +1,34 @@
+// Package har provides http client which records requests to
+// MyAnimeList as HTTP Archive (HAR 1.2) files and http client which
+// replays them.
+//
+// Recorder wraps another http client and writes every request and
+// response (url, headers, timing, status, and body) to a HAR file
+// in a directory. The file is rotated when it is bigger than
+// `Config.MaxFileSize` or older than `Config.RotateInterval`. The
+// file is kept as a valid HAR after every entry so it can still be
+// loaded if the program stops without closing the recorder. Failed
+// recording is only logged and does not fail the request.
+//
+// The response body is recorded while it is read by the caller and
+// only the first `Config.MaxBodySize` bytes (default 1 MiB) are kept.
+// The entry is written when the body is completely read or closed.
+//
+//	r, err := har.NewRecorder("/tmp/har")
+//	if err != nil {
+//		// handle error
+//	}
+//	defer r.Close()
+//
+//	m, err := malscraper.New(malscraper.Config{HTTPClient: r})
+//
+// Replayer returns the recorded responses instead of requesting
+// MyAnimeList so the HAR files can be used in tests.
+//
+//	r, err := har.NewReplayer("testdata/anime.har")
+//	if err != nil {
+//		// handle error
+//	}
+//
+//	m, err := malscraper.New(malscraper.Config{HTTPClient: r})
+package har
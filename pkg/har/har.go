@@ -0,0 +1,210 @@
+package har
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+	"unicode/utf8"
+)
+
+// HAR version and creator.
+const (
+	Version     = "1.2"
+	CreatorName = "go-malscraper"
+)
+
+// HAR is root object of HTTP Archive file.
+type HAR struct {
+	Log Log `json:"log"`
+}
+
+// Log contains all recorded entries.
+type Log struct {
+	Version string  `json:"version"`
+	Creator Creator `json:"creator"`
+	Entries []Entry `json:"entries"`
+}
+
+// Creator is the application which creates the file.
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Entry is a request and its response.
+type Entry struct {
+	StartedDateTime time.Time `json:"startedDateTime"`
+	// Total time in milliseconds.
+	Time     float64  `json:"time"`
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+	Cache    struct{} `json:"cache"`
+	Timings  Timings  `json:"timings"`
+	Comment  string   `json:"comment,omitempty"`
+}
+
+// Request is recorded request.
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []NameValue `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+// Response is recorded response.
+type Response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []NameValue `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	Content     Content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+// Content is response body.
+type Content struct {
+	// Original body size.
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	// Will be "base64" if the body is not a valid UTF-8 text.
+	Encoding string `json:"encoding,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+// NameValue is header, query string, or cookie.
+type NameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Timings is request timing in milliseconds. Only total
+// time is known, so it is all put in `Wait`.
+type Timings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// Load to read HAR file. Unfinished file from a recorder
+// which is not closed yet is also accepted.
+func Load(r io.Reader) (*HAR, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var h HAR
+	if err := json.Unmarshal(data, &h); err != nil {
+		// Try closing the unfinished file.
+		data = append(bytes.TrimRight(bytes.TrimSpace(data), ","), []byte(footer)...)
+		if err2 := json.Unmarshal(data, &h); err2 != nil {
+			return nil, err
+		}
+	}
+
+	return &h, nil
+}
+
+func newEntry(req *http.Request, resp *http.Response, body []byte, size int, start time.Time, d time.Duration, maxBodySize int) Entry {
+	ms := float64(d) / float64(time.Millisecond)
+	return Entry{
+		StartedDateTime: start,
+		Time:            ms,
+		Request: Request{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: req.Proto,
+			Cookies:     []NameValue{},
+			Headers:     toNameValues(req.Header),
+			QueryString: toNameValues(req.URL.Query()),
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Response: Response{
+			Status:      resp.StatusCode,
+			StatusText:  http.StatusText(resp.StatusCode),
+			HTTPVersion: resp.Proto,
+			Cookies:     []NameValue{},
+			Headers:     toNameValues(resp.Header),
+			Content:     newContent(resp.Header.Get("Content-Type"), body, size, maxBodySize),
+			RedirectURL: resp.Header.Get("Location"),
+			HeadersSize: -1,
+			BodySize:    size,
+		},
+		Timings: Timings{
+			Send:    0,
+			Wait:    ms,
+			Receive: 0,
+		},
+	}
+}
+
+// newContent to create response content from the body which
+// may be already cut and its complete size.
+func newContent(mimeType string, body []byte, size int, maxBodySize int) Content {
+	c := Content{
+		Size:     size,
+		MimeType: mimeType,
+	}
+
+	if maxBodySize > 0 && len(body) > maxBodySize {
+		// Don't cut in the middle of a UTF-8 character.
+		n := maxBodySize
+		for n > 0 && !utf8.RuneStart(body[n]) {
+			n--
+		}
+		body = body[:n]
+		c.Comment = "truncated"
+	}
+
+	if utf8.Valid(body) {
+		c.Text = string(body)
+	} else {
+		c.Text = base64.StdEncoding.EncodeToString(body)
+		c.Encoding = "base64"
+	}
+
+	return c
+}
+
+// body to get the decoded response body.
+func (c Content) body() ([]byte, error) {
+	if c.Encoding == "base64" {
+		return base64.StdEncoding.DecodeString(c.Text)
+	}
+	return []byte(c.Text), nil
+}
+
+func toNameValues(m map[string][]string) []NameValue {
+	nv := []NameValue{}
+	for name, values := range m {
+		for _, v := range values {
+			nv = append(nv, NameValue{Name: name, Value: v})
+		}
+	}
+	sort.SliceStable(nv, func(i, j int) bool {
+		return nv[i].Name < nv[j].Name
+	})
+	return nv
+}
+
+func fromNameValues(nv []NameValue) http.Header {
+	h := make(http.Header)
+	for _, v := range nv {
+		h.Add(v.Name, v.Value)
+	}
+	return h
+}
@@ -0,0 +1,277 @@
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClient struct {
+	bodies map[string]string
+}
+
+func (c *mockClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		Header:     http.Header{"Content-Type": []string{"text/html"}},
+		Body:       ioutil.NopCloser(strings.NewReader(c.bodies[req.URL.String()])),
+	}, nil
+}
+
+func mockTime(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	t.Cleanup(func() { timeNow = time.Now })
+}
+
+func get(t *testing.T, c interface {
+	Do(*http.Request) (*http.Response, error)
+}, url string) (int, string) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+	resp, err := c.Do(req)
+	if !assert.NoError(t, err) {
+		return 0, ""
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func loadDir(t *testing.T, dir string) []*HAR {
+	files, err := filepath.Glob(filepath.Join(dir, "*.har"))
+	assert.NoError(t, err)
+	var hars []*HAR
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		assert.NoError(t, err)
+		h, err := Load(bytes.NewReader(data))
+		assert.NoError(t, err)
+		hars = append(hars, h)
+	}
+	return hars
+}
+
+const (
+	animeURL = "https://myanimelist.net/anime/1"
+	userURL  = "https://myanimelist.net/profile/rl404"
+)
+
+var client = &mockClient{bodies: map[string]string{
+	animeURL: "<html>anime ☆</html>",
+	userURL:  "<html>user</html>",
+}}
+
+func TestRecordReplay(t *testing.T) {
+	mockTime(t)
+	dir := t.TempDir()
+
+	r, err := NewRecorderWithConfig(Config{Dir: dir, Client: client})
+	assert.NoError(t, err)
+
+	_, body := get(t, r, animeURL)
+	assert.Equal(t, client.bodies[animeURL], body)
+	get(t, r, userURL)
+
+	// File is valid before closed.
+	files, _ := filepath.Glob(filepath.Join(dir, "*.har"))
+	assert.Len(t, files, 1)
+	data, err := ioutil.ReadFile(files[0])
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(data))
+
+	hars := loadDir(t, dir)
+	assert.Len(t, hars, 1)
+	assert.Len(t, hars[0].Log.Entries, 2)
+
+	assert.NoError(t, r.Close())
+	hars = loadDir(t, dir)
+	assert.Len(t, hars, 1)
+
+	e := hars[0].Log.Entries[0]
+	assert.Equal(t, Version, hars[0].Log.Version)
+	assert.Equal(t, animeURL, e.Request.URL)
+	assert.Equal(t, http.StatusOK, e.Response.Status)
+	assert.Equal(t, "text/html", e.Response.Content.MimeType)
+	assert.Equal(t, client.bodies[animeURL], e.Response.Content.Text)
+	assert.Equal(t, float64(1000), e.Time)
+
+	rp, err := NewReplayer(files...)
+	assert.NoError(t, err)
+
+	code, body := get(t, rp, animeURL)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, client.bodies[animeURL], body)
+
+	// Repeat last response.
+	_, body = get(t, rp, animeURL)
+	assert.Equal(t, client.bodies[animeURL], body)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://myanimelist.net/anime/2", nil)
+	_, err = rp.Do(req)
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestRotate(t *testing.T) {
+	t.Run("size", func(t *testing.T) {
+		mockTime(t)
+		dir := t.TempDir()
+
+		r, err := NewRecorderWithConfig(Config{Dir: dir, Client: client, MaxFileSize: 1})
+		assert.NoError(t, err)
+		get(t, r, animeURL)
+		get(t, r, animeURL)
+		get(t, r, userURL)
+		assert.NoError(t, r.Close())
+
+		hars := loadDir(t, dir)
+		assert.Len(t, hars, 3)
+		for _, h := range hars {
+			assert.Len(t, h.Log.Entries, 1)
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		mockTime(t)
+		dir := t.TempDir()
+
+		// Time moves 3 seconds per request.
+		r, err := NewRecorderWithConfig(Config{Dir: dir, Client: client, RotateInterval: 5 * time.Second})
+		assert.NoError(t, err)
+		for i := 0; i < 4; i++ {
+			get(t, r, animeURL)
+		}
+		assert.NoError(t, r.Close())
+
+		hars := loadDir(t, dir)
+		assert.Len(t, hars, 2)
+	})
+}
+
+func TestFilterTruncate(t *testing.T) {
+	mockTime(t)
+	dir := t.TempDir()
+
+	r, err := NewRecorderWithConfig(Config{
+		Dir:         dir,
+		Client:      client,
+		MaxBodySize: 13,
+		Filter: func(req *http.Request) bool {
+			return strings.Contains(req.URL.Path, "/anime/")
+		},
+	})
+	assert.NoError(t, err)
+
+	// Caller still gets complete body.
+	_, body := get(t, r, animeURL)
+	assert.Equal(t, client.bodies[animeURL], body)
+	get(t, r, userURL)
+	assert.NoError(t, r.Close())
+
+	hars := loadDir(t, dir)
+	assert.Len(t, hars, 1)
+	assert.Len(t, hars[0].Log.Entries, 1)
+
+	c := hars[0].Log.Entries[0].Response.Content
+	assert.Equal(t, "truncated", c.Comment)
+	assert.Equal(t, "<html>anime ", c.Text)
+	assert.Equal(t, len(client.bodies[animeURL]), c.Size)
+}
+
+func TestRecordClosed(t *testing.T) {
+	mockTime(t)
+	dir := t.TempDir()
+
+	r, err := NewRecorderWithConfig(Config{Dir: dir, Client: client})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultMaxBodySize, r.maxBodySize)
+
+	// Body is recorded as far as it is read.
+	req, _ := http.NewRequest(http.MethodGet, animeURL, nil)
+	resp, err := r.Do(req)
+	assert.NoError(t, err)
+	buf := make([]byte, 6)
+	_, err = io.ReadFull(resp.Body, buf)
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.NoError(t, resp.Body.Close())
+	assert.NoError(t, r.Close())
+
+	hars := loadDir(t, dir)
+	assert.Len(t, hars, 1)
+	assert.Len(t, hars[0].Log.Entries, 1)
+	assert.Equal(t, "<html>", hars[0].Log.Entries[0].Response.Content.Text)
+}
+
+func TestBinary(t *testing.T) {
+	c := newContent("image/png", []byte{0xff, 0x00}, 2, 0)
+	assert.Equal(t, "base64", c.Encoding)
+
+	b, err := c.body()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xff, 0x00}, b)
+}
+
+func TestNewRecorder(t *testing.T) {
+	_, err := NewRecorder("")
+	assert.Error(t, err)
+
+	_, err = NewReplayer(filepath.Join(os.TempDir(), "not-exist.har"))
+	assert.Error(t, err)
+}
+
+type blockClient struct {
+	mockClient
+	blocked io.ReadCloser
+}
+
+func (c *blockClient) Blocked(resp *http.Response) {
+	c.blocked = resp.Body
+}
+
+func TestBlocked(t *testing.T) {
+	mockTime(t)
+
+	c := &blockClient{mockClient: *client}
+	r, err := NewRecorderWithConfig(Config{Dir: t.TempDir(), Client: c})
+	assert.NoError(t, err)
+	defer r.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, animeURL, nil)
+	resp, err := r.Do(req)
+	assert.NoError(t, err)
+
+	// Client gets its own response body.
+	r.Blocked(resp)
+	assert.NotNil(t, c.blocked)
+	assert.Equal(t, resp.Body.(*recordedBody).original, c.blocked)
+}
+
+func TestRecordFailed(t *testing.T) {
+	mockTime(t)
+	dir := t.TempDir()
+
+	r, err := NewRecorderWithConfig(Config{Dir: dir, Client: client})
+	assert.NoError(t, err)
+	defer r.Close()
+
+	// Request still succeeds.
+	assert.NoError(t, os.RemoveAll(dir))
+	code, body := get(t, r, animeURL)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, client.bodies[animeURL], body)
+}
@@ -0,0 +1,318 @@
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rl404/go-malscraper/service"
+	"github.com/rl404/mal-plugin/log/mallogger"
+)
+
+// Recorder client implements HTTPClient and BlockHandler interface.
+var (
+	_ service.HTTPClient   = &Recorder{}
+	_ service.BlockHandler = &Recorder{}
+)
+
+// Testable time now func.
+var timeNow = time.Now
+
+// Default maximum recorded response body size (1 MiB).
+const defaultMaxBodySize = 1 << 20
+
+// Beginning and end of HAR file. Entries are written in between.
+// The end is written after every entry and overwritten by the next
+// one so the file is always a valid HAR.
+const (
+	header = `{"log":{"version":"` + Version + `","creator":{"name":"` + CreatorName + `","version":""},"entries":[`
+	footer = "]}}\n"
+)
+
+// Config is recorder config.
+type Config struct {
+	// Directory to store HAR files. Will be created if not exists.
+	Dir string
+	// Http client to actually send the request. Default is
+	// `*http.Client` with 10 seconds timeout.
+	Client service.HTTPClient
+	// Maximum recorded response body size in bytes. Longer body
+	// will be truncated. Default is 1 MiB. Negative means complete
+	// body.
+	MaxBodySize int
+	// Start a new file when the current file is bigger than this
+	// in bytes. Zero means no size limit.
+	MaxFileSize int64
+	// Start a new file when the current file is older than this.
+	// Zero means no time limit.
+	RotateInterval time.Duration
+	// Only record requests which this func returns true (e.g.
+	// only anime pages). Nil means all requests are recorded.
+	Filter func(*http.Request) bool
+	// Logger to log failed recording. Default only logs error.
+	Logger service.Logger
+}
+
+// Recorder is http client which records requests and
+// responses to HAR files.
+type Recorder struct {
+	dir            string
+	client         service.HTTPClient
+	maxBodySize    int
+	maxFileSize    int64
+	rotateInterval time.Duration
+	filter         func(*http.Request) bool
+	logger         service.Logger
+
+	mu       sync.Mutex
+	file     *os.File
+	fileSize int64
+	openedAt time.Time
+	entries  int
+}
+
+// NewRecorder to create new recorder with default config.
+func NewRecorder(dir string) (*Recorder, error) {
+	return NewRecorderWithConfig(Config{
+		Dir: dir,
+	})
+}
+
+// NewRecorderWithConfig to create new recorder with config.
+func NewRecorderWithConfig(cfg Config) (*Recorder, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("empty HAR dir")
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, err
+	}
+
+	if cfg.Logger == nil {
+		cfg.Logger = mallogger.New(1, false)
+	}
+
+	if cfg.MaxBodySize == 0 {
+		cfg.MaxBodySize = defaultMaxBodySize
+	}
+
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{
+			Timeout: 10 * time.Second,
+		}
+	}
+
+	r := &Recorder{
+		dir:            cfg.Dir,
+		client:         cfg.Client,
+		maxBodySize:    cfg.MaxBodySize,
+		maxFileSize:    cfg.MaxFileSize,
+		rotateInterval: cfg.RotateInterval,
+		filter:         cfg.Filter,
+		logger:         cfg.Logger,
+	}
+
+	return r, nil
+}
+
+// Do to send the request and record it. The response body
+// is recorded while the caller reads it and the entry is
+// written when the body is completely read or closed.
+// Failed recording is only logged, the response is still
+// returned.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	if r.filter != nil && !r.filter(req) {
+		return r.client.Do(req)
+	}
+
+	start := timeNow()
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = &recordedBody{
+		original: resp.Body,
+		recorder: r,
+		req:      req,
+		resp:     resp,
+		start:    start,
+	}
+
+	return resp, nil
+}
+
+// recordedBody is the response body which keeps the first
+// `maxBodySize` bytes while being read and keeps the original
+// one so the client can still recognize it.
+type recordedBody struct {
+	original io.ReadCloser
+	recorder *Recorder
+	req      *http.Request
+	resp     *http.Response
+	start    time.Time
+
+	// One more byte than the limit is kept to know
+	// where to truncate the body.
+	body bytes.Buffer
+	size int
+	once sync.Once
+}
+
+func (b *recordedBody) Read(p []byte) (int, error) {
+	n, err := b.original.Read(p)
+	b.size += n
+
+	keep := n
+	if max := b.recorder.maxBodySize; max > 0 && b.body.Len()+keep > max+1 {
+		keep = max + 1 - b.body.Len()
+	}
+	if keep > 0 {
+		b.body.Write(p[:keep])
+	}
+
+	if err == io.EOF {
+		b.record()
+	}
+
+	return n, err
+}
+
+func (b *recordedBody) Close() error {
+	err := b.original.Close()
+	b.record()
+	return err
+}
+
+// record to write the entry once.
+func (b *recordedBody) record() {
+	b.once.Do(func() {
+		r := b.recorder
+		entry := newEntry(b.req, b.resp, b.body.Bytes(), b.size, b.start, timeNow().Sub(b.start), r.maxBodySize)
+		if err := r.write(entry); err != nil {
+			r.logger.Error("failed recording %s: %s", b.req.URL.String(), err.Error())
+		}
+	})
+}
+
+// Blocked to pass the blocked response to the client
+// if the client can handle it (e.g. proxy pool).
+func (r *Recorder) Blocked(resp *http.Response) {
+	h, ok := r.client.(service.BlockHandler)
+	if !ok {
+		return
+	}
+
+	if b, ok := resp.Body.(*recordedBody); ok {
+		original := *resp
+		original.Body = b.original
+		resp = &original
+	}
+
+	h.Blocked(resp)
+}
+
+// Close to finish the current HAR file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closeFile()
+}
+
+func (r *Recorder) write(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.rotate(); err != nil {
+		return err
+	}
+
+	if r.entries > 0 {
+		data = append([]byte(","), data...)
+	}
+
+	if err := r.writeEntry(data); err != nil {
+		// The file may be broken, start a new one.
+		r.file.Close()
+		r.file = nil
+		return err
+	}
+
+	r.entries++
+	return nil
+}
+
+// writeEntry to write the entry followed by the end of HAR
+// file then move back before the end so the next entry
+// overwrites it.
+func (r *Recorder) writeEntry(data []byte) error {
+	n, err := r.file.Write(append(data, footer...))
+	if err != nil {
+		return err
+	}
+
+	if _, err = r.file.Seek(-int64(len(footer)), io.SeekCurrent); err != nil {
+		return err
+	}
+
+	r.fileSize += int64(n - len(footer))
+	return nil
+}
+
+// rotate to start a new file if needed.
+func (r *Recorder) rotate() error {
+	if r.file != nil {
+		isBig := r.maxFileSize > 0 && r.fileSize >= r.maxFileSize
+		isOld := r.rotateInterval > 0 && !timeNow().Before(r.openedAt.Add(r.rotateInterval))
+		if !isBig && !isOld {
+			return nil
+		}
+		if err := r.closeFile(); err != nil {
+			return err
+		}
+	}
+
+	now := timeNow()
+	name := fmt.Sprintf("malscraper-%s.har", now.UTC().Format("20060102T150405.000000000"))
+
+	f, err := os.OpenFile(filepath.Join(r.dir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	r.file = f
+	r.fileSize = 0
+	r.openedAt = now
+	r.entries = 0
+
+	if err := r.writeEntry([]byte(header)); err != nil {
+		f.Close()
+		r.file = nil
+		return err
+	}
+
+	return nil
+}
+
+func (r *Recorder) closeFile() error {
+	if r.file == nil {
+		return nil
+	}
+
+	// The end of HAR file is already written.
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
@@ -0,0 +1,104 @@
+package har
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Replayer client implements HTTPClient interface.
+var _ service.HTTPClient = &Replayer{}
+
+// ErrNotFound will be returned if the request is not recorded.
+var ErrNotFound = errors.New("request not found in HAR")
+
+// Replayer is http client which responds requests with
+// recorded responses from HAR files instead of sending them.
+type Replayer struct {
+	sync.Mutex
+	entries map[string][]Entry
+	served  map[string]int
+}
+
+// NewReplayer to create new replayer from HAR files.
+func NewReplayer(files ...string) (*Replayer, error) {
+	r := &Replayer{
+		entries: make(map[string][]Entry),
+		served:  make(map[string]int),
+	}
+
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+
+		h, err := Load(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		r.Add(h)
+	}
+
+	return r, nil
+}
+
+// Add to add entries from loaded HAR.
+func (r *Replayer) Add(h *HAR) {
+	r.Lock()
+	defer r.Unlock()
+	for _, e := range h.Log.Entries {
+		key := replayKey(e.Request.Method, e.Request.URL)
+		r.entries[key] = append(r.entries[key], e)
+	}
+}
+
+// Do to respond the request with recorded response. Requests
+// to the same URL are responded in recorded order and the last
+// one is repeated.
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+	key := replayKey(req.Method, req.URL.String())
+
+	r.Lock()
+	entries := r.entries[key]
+	if len(entries) == 0 {
+		r.Unlock()
+		return nil, ErrNotFound
+	}
+	i := r.served[key]
+	if i < len(entries)-1 {
+		r.served[key]++
+	}
+	r.Unlock()
+
+	e := entries[i]
+	body, err := e.Response.Content.body()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        e.Response.StatusText,
+		StatusCode:    e.Response.Status,
+		Proto:         e.Response.HTTPVersion,
+		Header:        fromNameValues(e.Response.Headers),
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func replayKey(method, url string) string {
+	if method == "" {
+		method = http.MethodGet
+	}
+	return strings.ToUpper(method) + " " + url
+}
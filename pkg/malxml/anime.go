@@ -0,0 +1,120 @@
+package malxml
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/rl404/go-malscraper/model"
+)
+
+type animeList struct {
+	XMLName xml.Name    `xml:"myanimelist"`
+	Info    animeInfo   `xml:"myinfo"`
+	Anime   []animeItem `xml:"anime"`
+}
+
+type animeInfo struct {
+	Username    string `xml:"user_name"`
+	ExportType  int    `xml:"user_export_type"`
+	Total       int    `xml:"user_total_anime"`
+	Watching    int    `xml:"user_total_watching"`
+	Completed   int    `xml:"user_total_completed"`
+	OnHold      int    `xml:"user_total_onhold"`
+	Dropped     int    `xml:"user_total_dropped"`
+	PlanToWatch int    `xml:"user_total_plantowatch"`
+}
+
+type animeItem struct {
+	ID             int    `xml:"series_animedb_id"`
+	Title          cdata  `xml:"series_title"`
+	Type           string `xml:"series_type"`
+	Episode        int    `xml:"series_episodes"`
+	WatchedEpisode int    `xml:"my_watched_episodes"`
+	StartDate      string `xml:"my_start_date"`
+	FinishDate     string `xml:"my_finish_date"`
+	Score          int    `xml:"my_score"`
+	Storage        string `xml:"my_storage"`
+	Status         string `xml:"my_status"`
+	Comment        cdata  `xml:"my_comments"`
+	TimesWatched   int    `xml:"my_times_watched"`
+	Priority       string `xml:"my_priority"`
+	Tags           cdata  `xml:"my_tags"`
+	Rewatching     int    `xml:"my_rewatching"`
+	RewatchingEp   int    `xml:"my_rewatching_ep"`
+	UpdateOnImport int    `xml:"update_on_import"`
+}
+
+// EncodeAnime to write user anime list as MyAnimeList XML export format.
+func EncodeAnime(w io.Writer, username string, list []model.UserAnime) error {
+	data := animeList{
+		Info: animeInfo{
+			Username:   username,
+			ExportType: exportAnime,
+			Total:      len(list),
+		},
+		Anime: make([]animeItem, len(list)),
+	}
+
+	for i, a := range list {
+		switch a.Status {
+		case statusCurrent:
+			data.Info.Watching++
+		case statusCompleted:
+			data.Info.Completed++
+		case statusOnHold:
+			data.Info.OnHold++
+		case statusDropped:
+			data.Info.Dropped++
+		case statusPlanned:
+			data.Info.PlanToWatch++
+		}
+
+		data.Anime[i] = animeItem{
+			ID:             a.ID,
+			Title:          cdata{a.Title},
+			Type:           a.Type.String(),
+			Episode:        a.Episode,
+			WatchedEpisode: a.Progress,
+			StartDate:      dateToStr(a.WatchStart),
+			FinishDate:     dateToStr(a.WatchEnd),
+			Score:          a.Score,
+			Storage:        a.Storage,
+			Status:         animeStatuses[a.Status],
+			Priority:       priorityToStr(a.Priority),
+			Tags:           cdata{tagToStr(a.Tag)},
+			Rewatching:     boolToInt(a.IsRewatching),
+		}
+	}
+
+	return encode(w, data)
+}
+
+// DecodeAnime to read MyAnimeList XML anime list export format.
+func DecodeAnime(r io.Reader) ([]model.UserAnime, error) {
+	var data animeList
+	if err := xml.NewDecoder(r).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	list := make([]model.UserAnime, len(data.Anime))
+	for i, a := range data.Anime {
+		list[i] = model.UserAnime{
+			ID:           a.ID,
+			Title:        strings.TrimSpace(a.Title.Text),
+			Score:        a.Score,
+			Status:       strToStatus(animeStatuses, a.Status),
+			Type:         model.ParseAnimeType(a.Type),
+			Progress:     a.WatchedEpisode,
+			Episode:      a.Episode,
+			Tag:          strings.TrimSpace(a.Tags.Text),
+			WatchStart:   strToDate(a.StartDate),
+			WatchEnd:     strToDate(a.FinishDate),
+			IsRewatching: a.Rewatching == 1,
+			Storage:      strings.TrimSpace(a.Storage),
+			Priority:     strToPriority(a.Priority),
+		}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,85 @@
+package malxml
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errDummy = errors.New("dummy error")
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errDummy
+}
+
+func TestAnime(t *testing.T) {
+	start := time.Date(2021, 1, 2, 0, 0, 0, 0, time.Local)
+	end := time.Date(2021, 3, 4, 0, 0, 0, 0, time.Local)
+	list := []model.UserAnime{
+		{
+			ID:           1,
+			Title:        "Cowboy Bebop",
+			Score:        9,
+			Status:       statusCompleted,
+			Type:         model.AnimeTypeTV,
+			Progress:     26,
+			Episode:      26,
+			Tag:          "space, jazz",
+			WatchStart:   &start,
+			WatchEnd:     &end,
+			IsRewatching: true,
+			Storage:      "None",
+			Priority:     "High",
+		},
+		{
+			ID:       5,
+			Title:    "Cowboy Bebop: Tengoku no Tobira",
+			Status:   statusPlanned,
+			Type:     model.AnimeTypeMovie,
+			Episode:  1,
+			Tag:      "<nil>",
+			Priority: "Low",
+		},
+	}
+
+	t.Run("encode-error", func(t *testing.T) {
+		assert.EqualError(t, EncodeAnime(errWriter{}, "rl404", list), errDummy.Error())
+	})
+
+	t.Run("decode-error", func(t *testing.T) {
+		d, err := DecodeAnime(strings.NewReader("<myanimelist>"))
+		assert.Nil(t, d)
+		assert.Error(t, err)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeAnime(&buf, "rl404", list))
+
+		xml := buf.String()
+		assert.Contains(t, xml, "<user_name>rl404</user_name>")
+		assert.Contains(t, xml, "<user_total_anime>2</user_total_anime>")
+		assert.Contains(t, xml, "<user_total_completed>1</user_total_completed>")
+		assert.Contains(t, xml, "<user_total_plantowatch>1</user_total_plantowatch>")
+		assert.Contains(t, xml, "<series_title><![CDATA[Cowboy Bebop]]></series_title>")
+		assert.Contains(t, xml, "<my_start_date>2021-01-02</my_start_date>")
+		assert.Contains(t, xml, "<my_finish_date>0000-00-00</my_finish_date>")
+		assert.Contains(t, xml, "<my_status>Plan to Watch</my_status>")
+		assert.Contains(t, xml, "<my_priority>HIGH</my_priority>")
+		assert.Contains(t, xml, "<my_tags></my_tags>")
+
+		d, err := DecodeAnime(&buf)
+		require.NoError(t, err)
+
+		list[1].Tag = ""
+		assert.Equal(t, list, d)
+	})
+}
@@ -0,0 +1,18 @@
+// Package malxml provides encoder and decoder for MyAnimeList
+// XML list export format.
+//
+// The format is the same as the file you get from
+// https://myanimelist.net/panel.php?go=export so it can be imported
+// to MyAnimeList or other anime trackers.
+//
+//	// Get all user anime list.
+//	list, _, err := m.GetUserAnime("rl404", -1)
+//	if err != nil {
+//		// handle error
+//	}
+//
+//	// Write to file.
+//	if err := malxml.EncodeAnime(f, "rl404", list); err != nil {
+//		// handle error
+//	}
+package malxml
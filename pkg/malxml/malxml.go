@@ -0,0 +1,119 @@
+package malxml
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/rl404/go-malscraper/pkg/utils"
+)
+
+// Export types.
+const (
+	exportAnime = 1
+	exportManga = 2
+)
+
+const (
+	dateLayout = "2006-01-02"
+	emptyDate  = "0000-00-00"
+)
+
+// User list status.
+const (
+	statusCurrent   = 1
+	statusCompleted = 2
+	statusOnHold    = 3
+	statusDropped   = 4
+	statusPlanned   = 6
+)
+
+var animeStatuses = map[int]string{
+	statusCurrent:   "Watching",
+	statusCompleted: "Completed",
+	statusOnHold:    "On-Hold",
+	statusDropped:   "Dropped",
+	statusPlanned:   "Plan to Watch",
+}
+
+var mangaStatuses = map[int]string{
+	statusCurrent:   "Reading",
+	statusCompleted: "Completed",
+	statusOnHold:    "On-Hold",
+	statusDropped:   "Dropped",
+	statusPlanned:   "Plan to Read",
+}
+
+type cdata struct {
+	Text string `xml:",cdata"`
+}
+
+func encode(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "\t")
+	if err := e.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func dateToStr(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return emptyDate
+	}
+	return t.Format(dateLayout)
+}
+
+func strToDate(str string) *time.Time {
+	str = strings.TrimSpace(str)
+	if str == "" || str == emptyDate {
+		return nil
+	}
+	t := utils.ParseTime(dateLayout, str)
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func strToStatus(statuses map[int]string, str string) int {
+	str = strings.TrimSpace(str)
+	for k, v := range statuses {
+		if strings.EqualFold(v, str) {
+			return k
+		}
+	}
+	// Some exports use status number instead of name.
+	return utils.StrToNum(str)
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func tagToStr(tag string) string {
+	if tag == "<nil>" {
+		return ""
+	}
+	return tag
+}
+
+func priorityToStr(priority string) string {
+	return strings.ToUpper(strings.TrimSpace(priority))
+}
+
+func strToPriority(str string) string {
+	str = strings.ToLower(strings.TrimSpace(str))
+	if str == "" {
+		return ""
+	}
+	return strings.ToUpper(str[:1]) + str[1:]
+}
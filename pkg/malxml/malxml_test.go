@@ -0,0 +1,40 @@
+package malxml
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateToStr(t *testing.T) {
+	d := time.Date(2021, 4, 1, 0, 0, 0, 0, time.Local)
+	assert.Equal(t, emptyDate, dateToStr(nil))
+	assert.Equal(t, emptyDate, dateToStr(&time.Time{}))
+	assert.Equal(t, "2021-04-01", dateToStr(&d))
+}
+
+func TestStrToDate(t *testing.T) {
+	assert.Nil(t, strToDate(""))
+	assert.Nil(t, strToDate(emptyDate))
+	assert.Nil(t, strToDate("invalid"))
+	assert.Equal(t, time.Date(2021, 4, 1, 0, 0, 0, 0, time.Local), *strToDate("2021-04-01"))
+}
+
+func TestStrToStatus(t *testing.T) {
+	assert.Equal(t, statusPlanned, strToStatus(animeStatuses, "Plan to Watch"))
+	assert.Equal(t, statusOnHold, strToStatus(mangaStatuses, "on-hold"))
+	assert.Equal(t, statusCurrent, strToStatus(animeStatuses, "1"))
+	assert.Equal(t, 0, strToStatus(animeStatuses, "unknown"))
+}
+
+func TestPriority(t *testing.T) {
+	assert.Equal(t, "HIGH", priorityToStr(" High "))
+	assert.Equal(t, "Medium", strToPriority("MEDIUM"))
+	assert.Equal(t, "", strToPriority(""))
+}
+
+func TestTagToStr(t *testing.T) {
+	assert.Equal(t, "", tagToStr("<nil>"))
+	assert.Equal(t, "a, b", tagToStr("a, b"))
+}
@@ -0,0 +1,119 @@
+package malxml
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/rl404/go-malscraper/model"
+)
+
+type mangaList struct {
+	XMLName xml.Name    `xml:"myanimelist"`
+	Info    mangaInfo   `xml:"myinfo"`
+	Manga   []mangaItem `xml:"manga"`
+}
+
+type mangaInfo struct {
+	Username   string `xml:"user_name"`
+	ExportType int    `xml:"user_export_type"`
+	Total      int    `xml:"user_total_manga"`
+	Reading    int    `xml:"user_total_reading"`
+	Completed  int    `xml:"user_total_completed"`
+	OnHold     int    `xml:"user_total_onhold"`
+	Dropped    int    `xml:"user_total_dropped"`
+	PlanToRead int    `xml:"user_total_plantoread"`
+}
+
+type mangaItem struct {
+	ID             int    `xml:"manga_mangadb_id"`
+	Title          cdata  `xml:"manga_title"`
+	Volume         int    `xml:"manga_volumes"`
+	Chapter        int    `xml:"manga_chapters"`
+	ReadVolume     int    `xml:"my_read_volumes"`
+	ReadChapter    int    `xml:"my_read_chapters"`
+	StartDate      string `xml:"my_start_date"`
+	FinishDate     string `xml:"my_finish_date"`
+	Score          int    `xml:"my_score"`
+	Status         string `xml:"my_status"`
+	Comment        cdata  `xml:"my_comments"`
+	TimesRead      int    `xml:"my_times_read"`
+	Tags           cdata  `xml:"my_tags"`
+	Priority       string `xml:"my_priority"`
+	Rereading      int    `xml:"my_rereading"`
+	UpdateOnImport int    `xml:"update_on_import"`
+}
+
+// EncodeManga to write user manga list as MyAnimeList XML export format.
+func EncodeManga(w io.Writer, username string, list []model.UserManga) error {
+	data := mangaList{
+		Info: mangaInfo{
+			Username:   username,
+			ExportType: exportManga,
+			Total:      len(list),
+		},
+		Manga: make([]mangaItem, len(list)),
+	}
+
+	for i, m := range list {
+		switch m.Status {
+		case statusCurrent:
+			data.Info.Reading++
+		case statusCompleted:
+			data.Info.Completed++
+		case statusOnHold:
+			data.Info.OnHold++
+		case statusDropped:
+			data.Info.Dropped++
+		case statusPlanned:
+			data.Info.PlanToRead++
+		}
+
+		data.Manga[i] = mangaItem{
+			ID:          m.ID,
+			Title:       cdata{m.Title},
+			Volume:      m.Volume,
+			Chapter:     m.Chapter,
+			ReadVolume:  m.VolumeProgress,
+			ReadChapter: m.ChapterProgress,
+			StartDate:   dateToStr(m.ReadStart),
+			FinishDate:  dateToStr(m.ReadEnd),
+			Score:       m.Score,
+			Status:      mangaStatuses[m.Status],
+			Tags:        cdata{tagToStr(m.Tag)},
+			Priority:    priorityToStr(m.Priority),
+			Rereading:   boolToInt(m.IsRereading),
+		}
+	}
+
+	return encode(w, data)
+}
+
+// DecodeManga to read MyAnimeList XML manga list export format.
+func DecodeManga(r io.Reader) ([]model.UserManga, error) {
+	var data mangaList
+	if err := xml.NewDecoder(r).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	list := make([]model.UserManga, len(data.Manga))
+	for i, m := range data.Manga {
+		list[i] = model.UserManga{
+			ID:              m.ID,
+			Title:           strings.TrimSpace(m.Title.Text),
+			Score:           m.Score,
+			Status:          strToStatus(mangaStatuses, m.Status),
+			ChapterProgress: m.ReadChapter,
+			VolumeProgress:  m.ReadVolume,
+			Chapter:         m.Chapter,
+			Volume:          m.Volume,
+			Tag:             strings.TrimSpace(m.Tags.Text),
+			ReadStart:       strToDate(m.StartDate),
+			ReadEnd:         strToDate(m.FinishDate),
+			IsRereading:     m.Rereading == 1,
+			Priority:        strToPriority(m.Priority),
+		}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,66 @@
+package malxml
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManga(t *testing.T) {
+	start := time.Date(2020, 12, 31, 0, 0, 0, 0, time.Local)
+	list := []model.UserManga{
+		{
+			ID:              2,
+			Title:           "Berserk",
+			Score:           10,
+			Status:          statusCurrent,
+			ChapterProgress: 300,
+			VolumeProgress:  35,
+			Tag:             "dark",
+			ReadStart:       &start,
+			Priority:        "Medium",
+		},
+		{
+			ID:          1,
+			Title:       "Monster",
+			Score:       8,
+			Status:      statusDropped,
+			Chapter:     162,
+			Volume:      18,
+			IsRereading: true,
+		},
+	}
+
+	t.Run("encode-error", func(t *testing.T) {
+		assert.EqualError(t, EncodeManga(errWriter{}, "rl404", list), errDummy.Error())
+	})
+
+	t.Run("decode-error", func(t *testing.T) {
+		d, err := DecodeManga(strings.NewReader("<myanimelist>"))
+		assert.Nil(t, d)
+		assert.Error(t, err)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeManga(&buf, "rl404", list))
+
+		xml := buf.String()
+		assert.Contains(t, xml, "<user_export_type>2</user_export_type>")
+		assert.Contains(t, xml, "<user_total_manga>2</user_total_manga>")
+		assert.Contains(t, xml, "<user_total_reading>1</user_total_reading>")
+		assert.Contains(t, xml, "<user_total_dropped>1</user_total_dropped>")
+		assert.Contains(t, xml, "<manga_title><![CDATA[Berserk]]></manga_title>")
+		assert.Contains(t, xml, "<my_status>Reading</my_status>")
+		assert.Contains(t, xml, "<my_rereading>1</my_rereading>")
+
+		d, err := DecodeManga(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, list, d)
+	})
+}
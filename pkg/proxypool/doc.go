@@ -0,0 +1,23 @@
+// Package proxypool provides http client which spreads requests
+// across several proxies.
+//
+// Each request uses 1 proxy chosen by round-robin or least recently
+// used selection. Every proxy has its own rate limiter so requests
+// through the same proxy are at least `Config.Interval` apart.
+//
+// Every proxy also has a health score, the ratio of successful
+// responses from its last `Config.HealthWindow` requests. Connection
+// errors and 5xx responses are failures. A proxy whose score drops
+// below `Config.MinHealth` is ejected for `Config.Cooldown`. A proxy
+// which is blocked by MyAnimeList (429 response or block page reported
+// by malscraper) goes into cooldown right away while the other proxies
+// are still used. If all proxies are in cooldown, requests fail with
+// `ErrNoProxy`.
+//
+//	c, err := proxypool.New("http://10.0.0.1:8080", "http://10.0.0.2:8080")
+//	if err != nil {
+//		// handle error
+//	}
+//
+//	m, err := malscraper.New(malscraper.Config{HTTPClient: c})
+package proxypool
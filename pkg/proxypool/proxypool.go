@@ -0,0 +1,331 @@
+package proxypool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Proxypool client implements HTTPClient and BlockHandler interface.
+var (
+	_ service.HTTPClient   = &Client{}
+	_ service.BlockHandler = &Client{}
+)
+
+// List of proxypool errors.
+var (
+	ErrEmptyProxy   = errors.New("empty proxy list")
+	ErrInvalidProxy = errors.New("invalid proxy URL")
+	ErrNoProxy      = errors.New("no available proxy")
+)
+
+// Testable time functions.
+var (
+	timeNow   = time.Now
+	timeAfter = time.After
+)
+
+// Proxy selection method.
+const (
+	RoundRobin = iota
+	LeastRecentlyUsed
+)
+
+// Config is proxypool config.
+type Config struct {
+	// List of proxy URLs (http://host:port, socks5://host:port, etc).
+	Proxies []string
+	// Proxy selection method, `RoundRobin` or `LeastRecentlyUsed`.
+	// Default is `RoundRobin`.
+	Selection int
+	// Minimum duration between requests through the same proxy.
+	// Zero means no limit.
+	Interval time.Duration
+	// Number of last responses used to count proxy health
+	// score. Default is 20.
+	HealthWindow int
+	// Proxy will be ejected if its health score (0-1) is lower
+	// than this. Default is 0.5. Negative value disables ejection.
+	MinHealth float64
+	// How long ejected or blocked proxy is not used. Blocked proxy
+	// uses `Retry-After` header instead if exists. Default is 1 minute.
+	Cooldown time.Duration
+	// Request timeout. Default is 10 seconds.
+	Timeout time.Duration
+}
+
+// Stat is proxy current state.
+type Stat struct {
+	URL           string    `json:"url"`
+	Score         float64   `json:"score"`
+	Requests      int       `json:"requests"`
+	LastUsed      time.Time `json:"lastUsed"`
+	CooldownUntil time.Time `json:"cooldownUntil"`
+}
+
+// Client is proxypool client.
+type Client struct {
+	selection int
+	interval  time.Duration
+	window    int
+	minHealth float64
+	cooldown  time.Duration
+
+	mu      sync.Mutex
+	proxies []*proxy
+	next    int
+}
+
+type proxy struct {
+	url    string
+	client *http.Client
+
+	// Guarded by Client.mu.
+	results       []bool
+	requests      int
+	lastUsed      time.Time
+	nextAllowed   time.Time
+	cooldownUntil time.Time
+}
+
+// body is response body which knows its proxy.
+type body struct {
+	io.ReadCloser
+	proxy *proxy
+}
+
+// New to create new proxy pool with default config.
+func New(proxies ...string) (*Client, error) {
+	return NewWithConfig(Config{
+		Proxies: proxies,
+	})
+}
+
+// NewWithConfig to create new proxy pool with config.
+func NewWithConfig(cfg Config) (*Client, error) {
+	if len(cfg.Proxies) == 0 {
+		return nil, ErrEmptyProxy
+	}
+
+	if cfg.HealthWindow <= 0 {
+		cfg.HealthWindow = 20
+	}
+
+	if cfg.MinHealth == 0 {
+		cfg.MinHealth = 0.5
+	}
+
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = time.Minute
+	}
+
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	c := &Client{
+		selection: cfg.Selection,
+		interval:  cfg.Interval,
+		window:    cfg.HealthWindow,
+		minHealth: cfg.MinHealth,
+		cooldown:  cfg.Cooldown,
+	}
+
+	for _, p := range cfg.Proxies {
+		u, err := url.Parse(p)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, ErrInvalidProxy
+		}
+		c.proxies = append(c.proxies, &proxy{
+			url: p,
+			client: &http.Client{
+				Timeout: cfg.Timeout,
+				Transport: &http.Transport{
+					Proxy: http.ProxyURL(u),
+				},
+			},
+		})
+	}
+
+	return c, nil
+}
+
+// Do to send the request through 1 of the proxies.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	p, wait, err := c.pick()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sleep(req.Context(), wait); err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	c.record(p, resp, err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the proxy so blocked response can be reported.
+	resp.Body = &body{ReadCloser: resp.Body, proxy: p}
+	return resp, nil
+}
+
+// Blocked to cool down the proxy which returned the blocked
+// response, including block page returned with 200. Other
+// proxies can still be used.
+func (c *Client) Blocked(resp *http.Response) {
+	b, ok := resp.Body.(*body)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b.proxy.cooldownUntil = timeNow().Add(getRetryAfter(resp.Header, c.cooldown))
+}
+
+// Stats to get all proxies current state.
+func (c *Client) Stats() []Stat {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := make([]Stat, len(c.proxies))
+	for i, p := range c.proxies {
+		stats[i] = Stat{
+			URL:           p.url,
+			Score:         p.score(),
+			Requests:      p.requests,
+			LastUsed:      p.lastUsed,
+			CooldownUntil: p.cooldownUntil,
+		}
+	}
+	return stats
+}
+
+// pick to choose proxy and reserve its rate limiter slot.
+// Returns how long to wait before sending the request.
+func (c *Client) pick() (*proxy, time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := timeNow()
+
+	var p *proxy
+	switch c.selection {
+	case LeastRecentlyUsed:
+		for _, pp := range c.proxies {
+			if now.Before(pp.cooldownUntil) {
+				continue
+			}
+			if p == nil || pp.lastUsed.Before(p.lastUsed) {
+				p = pp
+			}
+		}
+	default:
+		for i := range c.proxies {
+			pp := c.proxies[(c.next+i)%len(c.proxies)]
+			if now.Before(pp.cooldownUntil) {
+				continue
+			}
+			p = pp
+			c.next = (c.next + i + 1) % len(c.proxies)
+			break
+		}
+	}
+
+	if p == nil {
+		return nil, 0, ErrNoProxy
+	}
+
+	start := now
+	if p.nextAllowed.After(start) {
+		start = p.nextAllowed
+	}
+	p.nextAllowed = start.Add(c.interval)
+	p.lastUsed = start
+	p.requests++
+
+	return p, start.Sub(now), nil
+}
+
+// record to update proxy health from the response.
+func (c *Client) record(p *proxy, resp *http.Response, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil && isBlocked(resp.StatusCode) {
+		p.cooldownUntil = timeNow().Add(getRetryAfter(resp.Header, c.cooldown))
+		return
+	}
+
+	p.results = append(p.results, err == nil && resp.StatusCode < http.StatusInternalServerError)
+	if len(p.results) > c.window {
+		p.results = p.results[len(p.results)-c.window:]
+	}
+
+	if len(p.results) >= minResults(c.window) && p.score() < c.minHealth {
+		p.cooldownUntil = timeNow().Add(c.cooldown)
+		// Give it a fresh start after cooldown.
+		p.results = nil
+	}
+}
+
+// score to get ratio of successful responses. Proxy
+// without any response yet is considered healthy.
+func (p *proxy) score() float64 {
+	if len(p.results) == 0 {
+		return 1
+	}
+
+	var ok int
+	for _, r := range p.results {
+		if r {
+			ok++
+		}
+	}
+	return float64(ok) / float64(len(p.results))
+}
+
+// minResults to get minimum number of responses before
+// proxy can be ejected, so 1 failure won't eject it.
+func minResults(window int) int {
+	if window < 5 {
+		return window
+	}
+	return 5
+}
+
+func isBlocked(code int) bool {
+	return code == http.StatusTooManyRequests
+}
+
+// getRetryAfter to get cooldown duration from `Retry-After`
+// header in seconds. HTTP-date format is not supported.
+func getRetryAfter(header http.Header, def time.Duration) time.Duration {
+	s, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || s <= 0 {
+		return def
+	}
+	return time.Duration(s) * time.Second
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeAfter(d):
+		return nil
+	}
+}
@@ -0,0 +1,271 @@
+package proxypool
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const targetURL = "http://myanimelist.test/anime/1"
+
+// testProxy is local proxy which responds to every
+// request itself with its current status code and body.
+type testProxy struct {
+	*httptest.Server
+	mu   sync.Mutex
+	code int
+	body string
+	hits int
+}
+
+func newTestProxy(t *testing.T, code int) *testProxy {
+	p := &testProxy{code: code}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.hits++
+		if p.code == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "120")
+		}
+		w.WriteHeader(p.code)
+		w.Write([]byte(p.body))
+	}))
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *testProxy) setCode(code int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.code = code
+}
+
+func (p *testProxy) getHits() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.hits
+}
+
+func get(t *testing.T, c *Client) (int, error) {
+	req, err := http.NewRequest(http.MethodGet, targetURL, nil)
+	assert.NoError(t, err)
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func TestNew(t *testing.T) {
+	_, err := New()
+	assert.Equal(t, ErrEmptyProxy, err)
+
+	_, err = New("localhost")
+	assert.Equal(t, ErrInvalidProxy, err)
+
+	c, err := New("http://localhost:8080")
+	assert.NoError(t, err)
+	assert.Len(t, c.Stats(), 1)
+}
+
+func TestRoundRobin(t *testing.T) {
+	p1, p2 := newTestProxy(t, http.StatusOK), newTestProxy(t, http.StatusOK)
+	c, err := New(p1.URL, p2.URL)
+	assert.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		code, err := get(t, c)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+	}
+
+	assert.Equal(t, 2, p1.getHits())
+	assert.Equal(t, 2, p2.getHits())
+}
+
+func TestLeastRecentlyUsed(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	p1, p2, p3 := newTestProxy(t, http.StatusOK), newTestProxy(t, http.StatusOK), newTestProxy(t, http.StatusOK)
+	c, err := NewWithConfig(Config{
+		Proxies:   []string{p1.URL, p2.URL, p3.URL},
+		Selection: LeastRecentlyUsed,
+	})
+	assert.NoError(t, err)
+
+	for _, p := range []*testProxy{p1, p2, p3, p1} {
+		now = now.Add(time.Second)
+		hits := p.getHits()
+		_, err := get(t, c)
+		assert.NoError(t, err)
+		assert.Equal(t, hits+1, p.getHits())
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	var waits []time.Duration
+	timeAfter = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		ch := make(chan time.Time, 1)
+		ch <- now
+		return ch
+	}
+	defer func() {
+		timeNow = time.Now
+		timeAfter = time.After
+	}()
+
+	p1 := newTestProxy(t, http.StatusOK)
+	c, err := NewWithConfig(Config{
+		Proxies:  []string{p1.URL},
+		Interval: time.Second,
+	})
+	assert.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err := get(t, c)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, waits)
+
+	// Cancelled while waiting.
+	timeAfter = func(time.Duration) <-chan time.Time { return nil }
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequest(http.MethodGet, targetURL, nil)
+	_, err = c.Do(req.WithContext(ctx))
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestHealth(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	p1, p2 := newTestProxy(t, http.StatusBadGateway), newTestProxy(t, http.StatusOK)
+	c, err := NewWithConfig(Config{
+		Proxies:      []string{p1.URL, p2.URL},
+		HealthWindow: 4,
+		Cooldown:     time.Minute,
+	})
+	assert.NoError(t, err)
+
+	// p1 keeps failing until ejected.
+	for i := 0; i < 8; i++ {
+		_, err := get(t, c)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 4, p1.getHits())
+	assert.Equal(t, float64(1), c.Stats()[0].Score)
+	assert.Equal(t, now.Add(time.Minute), c.Stats()[0].CooldownUntil)
+
+	// Only p2 is used while p1 is ejected.
+	for i := 0; i < 2; i++ {
+		_, err := get(t, c)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 4, p1.getHits())
+	assert.Equal(t, 6, p2.getHits())
+
+	// p1 is back after cooldown.
+	now = now.Add(time.Minute)
+	p1.setCode(http.StatusOK)
+	for i := 0; i < 2; i++ {
+		_, err := get(t, c)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 5, p1.getHits())
+}
+
+func TestBlocked(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	p1 := newTestProxy(t, http.StatusTooManyRequests)
+	c, err := New(p1.URL)
+	assert.NoError(t, err)
+
+	code, err := get(t, c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	assert.Equal(t, now.Add(2*time.Minute), c.Stats()[0].CooldownUntil)
+
+	_, err = get(t, c)
+	assert.Equal(t, ErrNoProxy, err)
+	assert.Equal(t, 1, p1.getHits())
+
+	now = now.Add(2 * time.Minute)
+	p1.setCode(http.StatusOK)
+	code, err = get(t, c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestBlockedPage(t *testing.T) {
+	now := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	// Blocked proxy returns block page with 200.
+	blocked := newTestProxy(t, http.StatusOK)
+	blocked.body = "<title>Too Many Requests</title>"
+	healthy := newTestProxy(t, http.StatusOK)
+
+	c, err := New(blocked.URL, healthy.URL)
+	assert.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		req, err := http.NewRequest(http.MethodGet, targetURL, nil)
+		assert.NoError(t, err)
+		resp, err := c.Do(req)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// Caller detects the block page and reports it.
+		b, _ := ioutil.ReadAll(resp.Body)
+		if strings.Contains(string(b), "Too Many Requests") {
+			c.Blocked(resp)
+		}
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, 1, blocked.getHits())
+	assert.Equal(t, 3, healthy.getHits())
+	assert.Equal(t, now.Add(time.Minute), c.Stats()[0].CooldownUntil)
+	assert.True(t, c.Stats()[1].CooldownUntil.IsZero())
+
+	// Response not from the pool is ignored.
+	c.Blocked(&http.Response{Body: http.NoBody})
+}
+
+func TestDeadProxy(t *testing.T) {
+	p1 := newTestProxy(t, http.StatusOK)
+	p1.Close()
+
+	c, err := NewWithConfig(Config{
+		Proxies:      []string{p1.URL},
+		HealthWindow: 1,
+	})
+	assert.NoError(t, err)
+
+	_, err = get(t, c)
+	assert.Error(t, err)
+	assert.Equal(t, float64(1), c.Stats()[0].Score)
+
+	_, err = get(t, c)
+	assert.Equal(t, ErrNoProxy, err)
+}
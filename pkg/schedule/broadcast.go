@@ -0,0 +1,73 @@
+package schedule
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/utils"
+)
+
+var days = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Broadcast time zone abbreviation and its IANA name.
+var timezones = map[string]string{
+	"JST": "Asia/Tokyo",
+	"KST": "Asia/Seoul",
+	"CST": "Asia/Shanghai",
+	"UTC": "UTC",
+}
+
+var broadcastRegex = regexp.MustCompile(`^([a-zA-Z]+?)s? at ([0-9]{1,2}):([0-9]{2}) \(([A-Za-z]+)\)$`)
+
+// ParseBroadcast to parse MyAnimeList anime broadcast string.
+// Example: "Saturdays at 01:00 (JST)".
+//
+// Will return broadcast with `Unknown` true if the day or
+// time is not known ("Unknown", "Mondays at Unknown", etc).
+func ParseBroadcast(str string) model.Broadcast {
+	match := broadcastRegex.FindStringSubmatch(strings.TrimSpace(str))
+	if len(match) == 0 {
+		return model.Broadcast{Unknown: true}
+	}
+
+	day, ok := days[strings.ToLower(match[1])]
+	if !ok {
+		return model.Broadcast{Unknown: true}
+	}
+
+	hour, minute := utils.StrToNum(match[2]), utils.StrToNum(match[3])
+	if hour > 23 || minute > 59 {
+		return model.Broadcast{Unknown: true}
+	}
+
+	tz := strings.ToUpper(match[4])
+	if name, ok := timezones[tz]; ok {
+		tz = name
+	}
+
+	return model.Broadcast{
+		Day:      day,
+		Hour:     hour,
+		Minute:   minute,
+		Timezone: tz,
+	}
+}
+
+// Location to get broadcast time zone location.
+// Will return JST location if the time zone is unknown.
+func Location(b model.Broadcast) *time.Location {
+	if loc, err := time.LoadLocation(b.Timezone); err == nil && b.Timezone != "" {
+		return loc
+	}
+	return time.FixedZone("JST", 9*60*60)
+}
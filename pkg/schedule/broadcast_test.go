@@ -0,0 +1,35 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBroadcast(t *testing.T) {
+	assert.Equal(t, model.Broadcast{Unknown: true}, ParseBroadcast(""))
+	assert.Equal(t, model.Broadcast{Unknown: true}, ParseBroadcast("Unknown"))
+	assert.Equal(t, model.Broadcast{Unknown: true}, ParseBroadcast("Mondays at Unknown"))
+	assert.Equal(t, model.Broadcast{Unknown: true}, ParseBroadcast("Someday at 01:00 (JST)"))
+	assert.Equal(t, model.Broadcast{Unknown: true}, ParseBroadcast("Sundays at 25:00 (JST)"))
+	assert.Equal(t, model.Broadcast{
+		Day:      time.Saturday,
+		Hour:     1,
+		Minute:   0,
+		Timezone: "Asia/Tokyo",
+	}, ParseBroadcast(" Saturdays at 01:00 (JST) "))
+	assert.Equal(t, model.Broadcast{
+		Day:      time.Thursday,
+		Hour:     23,
+		Minute:   30,
+		Timezone: "PST",
+	}, ParseBroadcast("Thursdays at 23:30 (PST)"))
+}
+
+func TestLocation(t *testing.T) {
+	assert.Equal(t, "Asia/Tokyo", Location(model.Broadcast{Timezone: "Asia/Tokyo"}).String())
+	assert.Equal(t, "JST", Location(model.Broadcast{}).String())
+	assert.Equal(t, "JST", Location(model.Broadcast{Timezone: "PST"}).String())
+}
@@ -0,0 +1,9 @@
+// Package schedule provides anime broadcast time parser and
+// iCalendar (.ics) weekly airing schedule writer.
+//
+//	// Parse broadcast string.
+//	b := schedule.ParseBroadcast("Saturdays at 01:00 (JST)")
+//
+//	// Write anime airing schedule shown in local time zone.
+//	err := schedule.WriteICS(w, animeList, time.Local)
+package schedule
@@ -0,0 +1,303 @@
+package schedule
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/rl404/go-malscraper/pkg/utils"
+)
+
+const (
+	icsDateTime    = "20060102T150405"
+	icsDateTimeUTC = "20060102T150405Z"
+	icsLineLength  = 75
+
+	// Default episode duration if anime doesn't have one.
+	defaultDuration = 30 * time.Minute
+)
+
+// Testable time func.
+var timeNow = time.Now
+
+// WriteICS to write iCalendar feed containing weekly airing
+// schedule of the anime list. Param `loc` should be a named
+// location from `time.LoadLocation()`. Event times are written
+// in `loc` local time with its `VTIMEZONE` definition, or in UTC
+// if `loc` is nil or UTC.
+//
+// Anime airing date is used as the recurrence bounds. If `loc`
+// daylight saving time moves the broadcast local time during
+// the airing, each airing time is listed instead of weekly
+// recurrence rule. Anime with unknown broadcast time or unknown
+// airing start date is skipped.
+func WriteICS(w io.Writer, animes []model.Anime, loc *time.Location) error {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var events []event
+	for _, a := range animes {
+		if e, ok := newEvent(a); ok {
+			events = append(events, e)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	l := icsWriter{w: bw, loc: loc}
+
+	l.line("BEGIN:VCALENDAR")
+	l.line("VERSION:2.0")
+	l.line("PRODID:-//rl404//go-malscraper//EN")
+	l.line("CALSCALE:GREGORIAN")
+	l.line("X-WR-TIMEZONE:" + loc.String())
+
+	if !l.isUTC() && len(events) > 0 {
+		from, to := getRange(events)
+		l.timezone(from, to)
+	}
+
+	stamp := timeNow().UTC().Format(icsDateTimeUTC)
+	for _, e := range events {
+		l.line("BEGIN:VEVENT")
+		l.line(fmt.Sprintf("UID:anime-%d@myanimelist.net", e.anime.ID))
+		l.line("DTSTAMP:" + stamp)
+		l.line("DTSTART" + l.dateTime(e.start))
+		l.line("DTEND" + l.dateTime(e.start.Add(e.duration)))
+		if dates, ok := l.airingDates(e); ok {
+			if len(dates) > 0 {
+				l.line("RDATE" + l.dateTime(dates...))
+			}
+		} else {
+			l.line("RRULE:" + getRule(e))
+		}
+		l.line("SUMMARY:" + escape(e.anime.Title))
+		l.line("URL:" + utils.BuildURL("https://myanimelist.net", "anime", e.anime.ID))
+		l.line("END:VEVENT")
+	}
+
+	l.line("END:VCALENDAR")
+
+	if l.err != nil {
+		return l.err
+	}
+	return bw.Flush()
+}
+
+// event is anime weekly airing schedule.
+type event struct {
+	anime    model.Anime
+	start    time.Time
+	duration time.Duration
+	// Last airing time. Zero if unknown.
+	until time.Time
+	// Number of airing. Zero if unknown.
+	count int
+}
+
+func newEvent(a model.Anime) (event, bool) {
+	b := a.BroadcastTime
+	if b == (model.Broadcast{}) {
+		b = ParseBroadcast(a.Broadcast)
+	}
+
+	start, ok := firstAiring(a.AiringDate.Start, b)
+	if !ok {
+		return event{}, false
+	}
+
+	duration := time.Duration(utils.GetDuration(a.Duration)) * time.Second
+	if duration <= 0 {
+		duration = defaultDuration
+	}
+
+	e := event{
+		anime:    a,
+		start:    start,
+		duration: duration,
+	}
+
+	end := a.AiringDate.End
+	if end.Year > 0 && end.Month > 0 && end.Day > 0 {
+		e.until = time.Date(end.Year, time.Month(end.Month), end.Day, b.Hour, b.Minute, 0, 0, start.Location())
+		if e.until.Before(start) {
+			e.until = start
+		}
+	} else if a.Episode > 0 {
+		e.count = a.Episode
+	}
+
+	return e, true
+}
+
+// firstAiring to get first broadcast time which is
+// on or after the airing start date.
+func firstAiring(d model.Date, b model.Broadcast) (time.Time, bool) {
+	if b.Unknown || d.Year == 0 || d.Month == 0 || d.Day == 0 {
+		return time.Time{}, false
+	}
+
+	t := time.Date(d.Year, time.Month(d.Month), d.Day, b.Hour, b.Minute, 0, 0, Location(b))
+	for t.Weekday() != b.Day {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t, true
+}
+
+// airings to get all airing times after the first one. Returns
+// false if the airing end is unknown.
+func (e event) airings() ([]time.Time, bool) {
+	if e.until.IsZero() && e.count == 0 {
+		return nil, false
+	}
+
+	var dates []time.Time
+	for i, t := 1, e.start.AddDate(0, 0, 7); ; i, t = i+1, t.AddDate(0, 0, 7) {
+		if !e.until.IsZero() && t.After(e.until) {
+			break
+		}
+		if e.count > 0 && i >= e.count {
+			break
+		}
+		dates = append(dates, t)
+	}
+
+	return dates, true
+}
+
+// lastAiring to get the last airing time. Returns 1 year
+// after now if the airing end is unknown.
+func (e event) lastAiring() time.Time {
+	dates, ok := e.airings()
+	if !ok {
+		last := timeNow().AddDate(1, 0, 0)
+		if last.Before(e.start) {
+			return e.start
+		}
+		return last
+	}
+	if len(dates) == 0 {
+		return e.start
+	}
+	return dates[len(dates)-1]
+}
+
+func getRule(e event) string {
+	rule := "FREQ=WEEKLY"
+
+	if !e.until.IsZero() {
+		return rule + ";UNTIL=" + e.until.UTC().Format(icsDateTimeUTC)
+	}
+
+	if e.count > 0 {
+		return fmt.Sprintf("%s;COUNT=%d", rule, e.count)
+	}
+
+	return rule
+}
+
+// getRange to get the first and last airing time of all events.
+func getRange(events []event) (from, to time.Time) {
+	for i, e := range events {
+		last := e.lastAiring().Add(e.duration)
+		if i == 0 || e.start.Before(from) {
+			from = e.start
+		}
+		if i == 0 || last.After(to) {
+			to = last
+		}
+	}
+	return from, to
+}
+
+func escape(str string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(str)
+}
+
+// icsWriter writes content line with CRLF line break and
+// folds line longer than 75 octets.
+type icsWriter struct {
+	w   io.Writer
+	loc *time.Location
+	err error
+}
+
+func (l *icsWriter) isUTC() bool {
+	return l.loc.String() == "UTC"
+}
+
+// dateTime to format times as property params and value in
+// the calendar time zone. For example, `;TZID=Asia/Tokyo:20210404T173000`.
+func (l *icsWriter) dateTime(times ...time.Time) string {
+	values := make([]string, len(times))
+	for i, t := range times {
+		if l.isUTC() {
+			values[i] = t.UTC().Format(icsDateTimeUTC)
+		} else {
+			values[i] = t.In(l.loc).Format(icsDateTime)
+		}
+	}
+
+	if l.isUTC() {
+		return ":" + strings.Join(values, ",")
+	}
+	return ";TZID=" + l.loc.String() + ":" + strings.Join(values, ",")
+}
+
+// airingDates to get all airing times after the first one if
+// the weekly recurrence rule can't be used because the airing
+// local time moves (daylight saving time). Returns false if the
+// recurrence rule should be used.
+func (l *icsWriter) airingDates(e event) ([]time.Time, bool) {
+	dates, ok := e.airings()
+	if !ok {
+		return nil, false
+	}
+
+	start := e.start.In(l.loc)
+	for _, d := range dates {
+		d = d.In(l.loc)
+		if d.Weekday() != start.Weekday() || d.Hour() != start.Hour() || d.Minute() != start.Minute() {
+			return dates, true
+		}
+	}
+
+	return nil, false
+}
+
+func (l *icsWriter) line(str string) {
+	if l.err != nil {
+		return
+	}
+
+	// Folded line starts with a space.
+	limit := icsLineLength
+	for len(str) > limit {
+		// Don't split multi-byte character.
+		i := limit
+		for i > 0 && !isRuneStart(str[i]) {
+			i--
+		}
+		if _, l.err = io.WriteString(l.w, str[:i]+"\r\n "); l.err != nil {
+			return
+		}
+		str = str[i:]
+		limit = icsLineLength - 1
+	}
+
+	_, l.err = io.WriteString(l.w, str+"\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
@@ -0,0 +1,128 @@
+package schedule
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rl404/go-malscraper/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errDummy = errors.New("dummy error")
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errDummy
+}
+
+func init() {
+	timeNow = func() time.Time {
+		return time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func TestWriteICS(t *testing.T) {
+	animes := []model.Anime{
+		{
+			ID:         1,
+			Title:      "Cowboy Bebop",
+			Broadcast:  "Saturdays at 01:00 (JST)",
+			Duration:   "24 min. per ep.",
+			AiringDate: model.StartEndDate{Start: model.Date{Year: 1998, Month: 4, Day: 3}, End: model.Date{Year: 1999, Month: 4, Day: 24}},
+		},
+		{
+			ID:            2,
+			Title:         "Some, Anime; " + strings.Repeat("long ", 20),
+			BroadcastTime: model.Broadcast{Day: time.Sunday, Hour: 17, Minute: 30, Timezone: "Asia/Tokyo"},
+			Episode:       12,
+			AiringDate:    model.StartEndDate{Start: model.Date{Year: 2021, Month: 4, Day: 4}},
+		},
+		{
+			ID:         3,
+			Title:      "Unknown broadcast",
+			Broadcast:  "Unknown",
+			AiringDate: model.StartEndDate{Start: model.Date{Year: 2021, Month: 4, Day: 4}},
+		},
+		{
+			ID:        4,
+			Title:     "Unknown start",
+			Broadcast: "Saturdays at 01:00 (JST)",
+		},
+	}
+
+	t.Run("error", func(t *testing.T) {
+		assert.EqualError(t, WriteICS(errWriter{}, animes, nil), errDummy.Error())
+	})
+
+	t.Run("utc", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteICS(&buf, animes, nil))
+
+		ics := buf.String()
+		assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"))
+		assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+		assert.Equal(t, 2, strings.Count(ics, "BEGIN:VEVENT"))
+		assert.Contains(t, ics, "UID:anime-1@myanimelist.net\r\n")
+		assert.Contains(t, ics, "DTSTAMP:20210401T000000Z\r\n")
+		assert.Contains(t, ics, "DTSTART:19980403T160000Z\r\n")
+		assert.Contains(t, ics, "DTEND:19980403T162400Z\r\n")
+		assert.Contains(t, ics, "RRULE:FREQ=WEEKLY;UNTIL=19990423T160000Z\r\n")
+		assert.Contains(t, ics, "DTSTART:20210404T083000Z\r\n")
+		assert.Contains(t, ics, "DTEND:20210404T090000Z\r\n")
+		assert.Contains(t, ics, "RRULE:FREQ=WEEKLY;COUNT=12\r\n")
+		assert.Contains(t, ics, `SUMMARY:Some\, Anime\; long`)
+		assert.NotContains(t, ics, "anime-3@")
+		assert.NotContains(t, ics, "anime-4@")
+
+		for _, line := range strings.Split(ics, "\r\n") {
+			assert.LessOrEqual(t, len(line), icsLineLength)
+		}
+	})
+
+	t.Run("location", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteICS(&buf, animes[:1], loc))
+
+		ics := buf.String()
+		assert.Contains(t, ics, "X-WR-TIMEZONE:America/New_York\r\n")
+		assert.Contains(t, ics, "BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n")
+		assert.Contains(t, ics, "BEGIN:DAYLIGHT\r\nDTSTART:19980405T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n")
+		assert.Contains(t, ics, "BEGIN:STANDARD\r\nDTSTART:19981025T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\n")
+		assert.Contains(t, ics, "DTSTART;TZID=America/New_York:19980403T110000\r\n")
+		assert.Contains(t, ics, "DTEND;TZID=America/New_York:19980403T112400\r\n")
+
+		// Broadcast local time moves with daylight saving time.
+		assert.Contains(t, ics, "RDATE;TZID=America/New_York:19980410T120000,")
+		assert.Contains(t, ics, "19981023T120000,19981030T110000")
+		assert.NotContains(t, ics, "RRULE")
+	})
+
+	t.Run("location-without-dst", func(t *testing.T) {
+		loc, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteICS(&buf, animes, loc))
+
+		ics := buf.String()
+		assert.Equal(t, 1, strings.Count(ics, "BEGIN:STANDARD"))
+		assert.NotContains(t, ics, "BEGIN:DAYLIGHT")
+		assert.Contains(t, ics, "DTSTART;TZID=Asia/Tokyo:19980404T010000\r\n")
+		assert.Contains(t, ics, "RRULE:FREQ=WEEKLY;UNTIL=19990423T160000Z\r\n")
+		assert.Contains(t, ics, "DTSTART;TZID=Asia/Tokyo:20210404T173000\r\n")
+		assert.Contains(t, ics, "RRULE:FREQ=WEEKLY;COUNT=12\r\n")
+		assert.NotContains(t, ics, "RDATE")
+	})
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne`, escape("a\\b;c,d\ne"))
+}
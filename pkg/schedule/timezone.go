@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+)
+
+// timezone to write `VTIMEZONE` of the calendar time zone
+// containing its offset changes between `from` and `to`.
+func (l *icsWriter) timezone(from, to time.Time) {
+	l.line("BEGIN:VTIMEZONE")
+	l.line("TZID:" + l.loc.String())
+
+	t := from.In(l.loc)
+	name, offset := t.Zone()
+	l.observance(t, offset, offset, name)
+
+	for t.Before(to) {
+		next := t.Add(24 * time.Hour)
+		if _, o := next.In(l.loc).Zone(); o == offset {
+			t = next
+			continue
+		}
+
+		t = findTransition(l.loc, t, next)
+		name, o := t.In(l.loc).Zone()
+		l.observance(t, offset, o, name)
+		offset = o
+	}
+
+	l.line("END:VTIMEZONE")
+}
+
+// observance to write time zone offset which starts at `t`.
+func (l *icsWriter) observance(t time.Time, from, to int, name string) {
+	kind := "STANDARD"
+	if isDaylight(l.loc, t, to) {
+		kind = "DAYLIGHT"
+	}
+
+	l.line("BEGIN:" + kind)
+	l.line("DTSTART:" + t.In(time.FixedZone("", from)).Format(icsDateTime))
+	l.line("TZOFFSETFROM:" + formatOffset(from))
+	l.line("TZOFFSETTO:" + formatOffset(to))
+	if name != "" {
+		l.line("TZNAME:" + name)
+	}
+	l.line("END:" + kind)
+}
+
+// findTransition to get the time when the offset changes
+// between `from` and `to`.
+func findTransition(loc *time.Location, from, to time.Time) time.Time {
+	_, offset := from.In(loc).Zone()
+	for to.Sub(from) > time.Second {
+		mid := from.Add(to.Sub(from) / 2)
+		if _, o := mid.In(loc).Zone(); o == offset {
+			from = mid
+		} else {
+			to = mid
+		}
+	}
+	return to
+}
+
+// isDaylight to check if the offset is daylight saving time
+// offset (bigger than the year's other offset).
+func isDaylight(loc *time.Location, t time.Time, offset int) bool {
+	year := t.In(loc).Year()
+	_, jan := time.Date(year, 1, 1, 0, 0, 0, 0, loc).Zone()
+	_, jul := time.Date(year, 7, 1, 0, 0, 0, 0, loc).Zone()
+	if jul < jan {
+		jan = jul
+	}
+	return offset > jan
+}
+
+// formatOffset to format offset in seconds as `+hhmm`.
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+}
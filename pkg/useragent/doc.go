@@ -0,0 +1,10 @@
+// Package useragent provides User-Agent provider which rotates
+// through a list of User-Agents.
+//
+// Each request gets the next User-Agent from the list. Default list
+// contains common desktop browsers' User-Agents.
+//
+//	m, err := malscraper.New(malscraper.Config{
+//		UserAgent: useragent.New(),
+//	})
+package useragent
@@ -0,0 +1,48 @@
+package useragent
+
+import (
+	"sync"
+
+	"github.com/rl404/go-malscraper/service"
+)
+
+// Rotator implements UserAgent interface.
+var _ service.UserAgent = &Rotator{}
+
+// DefaultList is default User-Agent list.
+var DefaultList = []string{
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/90.0.4430.93 Safari/537.36",
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:88.0) Gecko/20100101 Firefox/88.0",
+	"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.1 Safari/605.1.15",
+	"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/90.0.4430.93 Safari/537.36",
+	"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/90.0.4430.93 Safari/537.36",
+	"Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:88.0) Gecko/20100101 Firefox/88.0",
+}
+
+// Rotator is User-Agent provider which returns
+// User-Agent from the list in turn.
+type Rotator struct {
+	sync.Mutex
+	agents []string
+	next   int
+}
+
+// New to create new User-Agent rotator. Will use
+// `DefaultList` if `agents` is empty.
+func New(agents ...string) *Rotator {
+	if len(agents) == 0 {
+		agents = DefaultList
+	}
+	return &Rotator{
+		agents: append([]string(nil), agents...),
+	}
+}
+
+// UserAgent to get the next User-Agent.
+func (r *Rotator) UserAgent() string {
+	r.Lock()
+	defer r.Unlock()
+	ua := r.agents[r.next]
+	r.next = (r.next + 1) % len(r.agents)
+	return ua
+}
@@ -0,0 +1,37 @@
+package useragent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotator(t *testing.T) {
+	r := New("a", "b", "c")
+	for _, ua := range []string{"a", "b", "c", "a"} {
+		assert.Equal(t, ua, r.UserAgent())
+	}
+}
+
+func TestDefault(t *testing.T) {
+	r := New()
+	seen := make(map[string]bool)
+	for range DefaultList {
+		seen[r.UserAgent()] = true
+	}
+	assert.Len(t, seen, len(DefaultList))
+}
+
+func TestConcurrent(t *testing.T) {
+	r := New("a", "b")
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NotEmpty(t, r.UserAgent())
+		}()
+	}
+	wg.Wait()
+}
@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"sort"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // StrToNum to convert string number to integer including comma removal (1,234 -> 1234).
@@ -68,3 +70,63 @@ func Ellipsis(str string, l int) string {
 	}
 	return str[:l] + "..."
 }
+
+// NormalizeTitle to normalize title for comparison. Lowercase,
+// remove symbols, and sort the words.
+// Example: "Cowboy Bebop: The Movie" => "bebop cowboy movie the".
+func NormalizeTitle(str string) string {
+	words := strings.FieldsFunc(strings.ToLower(str), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+// Similarity to get similarity score of 2 titles based on
+// Levenshtein distance of the normalized titles. The score
+// is between 0 (different) and 1 (same).
+func Similarity(str1, str2 string) float64 {
+	r1, r2 := []rune(NormalizeTitle(str1)), []rune(NormalizeTitle(str2))
+	if len(r1) == 0 && len(r2) == 0 {
+		return 0
+	}
+
+	maxLen := len(r1)
+	if len(r2) > maxLen {
+		maxLen = len(r2)
+	}
+
+	return 1 - float64(levenshtein(r1, r2))/float64(maxLen)
+}
+
+func levenshtein(r1, r2 []rune) int {
+	prev := make([]int, len(r2)+1)
+	curr := make([]int, len(r2)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(r1); i++ {
+		curr[0] = i
+		for j := 1; j <= len(r2); j++ {
+			cost := 1
+			if r1[i-1] == r2[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(r2)]
+}
+
+func minInt(nums ...int) int {
+	min := nums[0]
+	for _, n := range nums[1:] {
+		if n < min {
+			min = n
+		}
+	}
+	return min
+}
@@ -51,3 +51,18 @@ func TestEllipsis(t *testing.T) {
 	assert.Equal(t, "123...", Ellipsis("123456789", 3))
 	assert.Equal(t, "123456789", Ellipsis("123456789", 20))
 }
+
+func TestNormalizeTitle(t *testing.T) {
+	assert.Equal(t, "", NormalizeTitle(" :: "))
+	assert.Equal(t, "bebop cowboy movie the", NormalizeTitle("Cowboy Bebop: The Movie"))
+	assert.Equal(t, "カウボーイビバップ", NormalizeTitle("カウボーイビバップ"))
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 0.0, Similarity("", ""))
+	assert.Equal(t, 0.0, Similarity("abc", ""))
+	assert.Equal(t, 1.0, Similarity("Cowboy Bebop", "cowboy bebop!"))
+	assert.Equal(t, 1.0, Similarity("Bebop Cowboy", "Cowboy Bebop"))
+	assert.Equal(t, 0.75, Similarity("abcd", "abce"))
+	assert.True(t, Similarity("Shingeki no Kyojin", "Shingeki no Kyojin Season 2") > Similarity("Shingeki no Kyojin", "Kimi no Na wa"))
+}
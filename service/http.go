@@ -0,0 +1,31 @@
+package service
+
+import "net/http"
+
+// HTTPClient is http client interface used to request
+// MyAnimeList web. `*http.Client` implements this interface.
+// If you want to use custom client (proxy, etc), try to
+// implement this interface.
+type HTTPClient interface {
+	// Send the request and return the response.
+	Do(*http.Request) (*http.Response, error)
+}
+
+// BlockHandler is optional interface for HTTPClient which
+// handles MyAnimeList block by itself (e.g. proxy pool which
+// only cools down the blocked proxy). If the HTTPClient
+// implements this, requests will not be paused for all when
+// blocked. The blocked response (429 or block page returned
+// with 200) will be passed to `Blocked()` instead.
+type BlockHandler interface {
+	// Called with the blocked response before its body is closed.
+	Blocked(*http.Response)
+}
+
+// UserAgent is User-Agent header provider. Will be called
+// for every request to MyAnimeList so it can return
+// different User-Agent each time.
+type UserAgent interface {
+	// Get User-Agent for the next request.
+	UserAgent() string
+}
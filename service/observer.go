@@ -0,0 +1,10 @@
+package service
+
+// Observer is metrics interface for malscraper internal
+// events (negative cache hit, etc). If you want to export
+// them to your metrics system (prometheus, statsd, etc),
+// try to implement this interface.
+type Observer interface {
+	// Increment counter `name` with its labels.
+	Inc(name string, labels map[string]string)
+}